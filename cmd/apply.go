@@ -2,12 +2,18 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	workv1 "open-cluster-management.io/api/work/v1"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/internal/manifestwork"
@@ -16,13 +22,26 @@ import (
 
 // ApplyFlags contains flags for the apply command
 type ApplyFlags struct {
-	ManifestFile string
+	ManifestFile string // File, directory or glob of ManifestWork files
 	Consumer     string
 	Wait         string // Condition to wait for (empty = no wait)
+	Prune        bool   // Delete ManifestWorks matching Selector that are not in the applied set
+	Selector     string // Label selector scoping --prune
+	DryRun       bool
+	Yes          bool // Skip the prune confirmation prompt
+	Validate     bool // Structurally check the manifest before sending it
+	Concurrency  int  // Parallel requests when pruning
+	Force        bool // Update even if the ManifestWork changed since it was read
 	// Global flags
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -44,6 +63,10 @@ func NewApplyCommand() *cobra.Command {
 		Long: `Apply a ManifestWork resource to a target cluster via Maestro.
 Creates a new ManifestWork or updates an existing one with the same name.
 
+--manifest-file also accepts a directory, applying every .yaml, .yml and .json
+file under it (symlinks are followed), or a glob such as 'manifests/*.yaml',
+which is expanded by maestro-cli itself. A glob that matches nothing is an error.
+
 Examples:
   # Apply a ManifestWork (no wait)
   maestro-cli apply --manifest-file=nodepool.yaml --consumer=cluster-west-1
@@ -60,15 +83,43 @@ Examples:
 
   # Apply with timeout (default 5m if not specified)
   maestro-cli apply --manifest-file=nodepool.yaml --consumer=cluster-west-1 \
-    --wait --timeout=10m --results-path=/shared/results.json`,
+    --wait --timeout=10m --results-path=/shared/results.json
+
+  # Attribute the change to a specific pipeline (recorded in the hyperfleet.io/source-id annotation)
+  maestro-cli apply --manifest-file=nodepool.yaml --consumer=cluster-west-1 --source-id=nodepool-adapter
+
+  # Apply every ManifestWork in a directory, or matching a glob
+  maestro-cli apply --manifest-file=manifests/ --consumer=cluster-west-1
+  maestro-cli apply --manifest-file='manifests/*.yaml' --consumer=cluster-west-1
+
+  # Preview which ManifestWorks labelled app=nginx would be pruned
+  maestro-cli apply --manifest-file=nginx.yaml --consumer=cluster-west-1 \
+    --prune --selector=app=nginx --dry-run
+
+  # Apply and prune without prompting (e.g. from a GitOps pipeline)
+  maestro-cli apply --manifest-file=nginx.yaml --consumer=cluster-west-1 \
+    --prune --selector=app=nginx --yes`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			flags := &ApplyFlags{
 				ManifestFile:        getStringFlag(cmd, "manifest-file"),
 				Consumer:            getStringFlag(cmd, "consumer"),
 				Wait:                getStringFlag(cmd, "wait"),
+				Prune:               getBoolFlag(cmd, "prune"),
+				Selector:            getStringFlag(cmd, "selector"),
+				DryRun:              getBoolFlag(cmd, "dry-run"),
+				Yes:                 getBoolFlag(cmd, "yes"),
+				Validate:            getBoolFlag(cmd, "validate"),
+				Concurrency:         getIntFlag(cmd, "concurrency"),
+				Force:               getBoolFlag(cmd, "force"),
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -87,12 +138,25 @@ Examples:
 	}
 
 	// Command-specific flags
-	cmd.Flags().String("manifest-file", "", "Path to ManifestWork YAML/JSON file (required)")
+	cmd.Flags().String("manifest-file", "",
+		"Path to a ManifestWork YAML/JSON file, a directory of them, or a glob such as 'manifests/*.yaml' (required)")
 	cmd.Flags().String("consumer", "", "Target cluster name (required)")
 	cmd.Flags().String(
 		"wait", "", "Wait for condition before exit (e.g., 'Available', 'Job:Complete', 'Job:Complete OR Job:Failed')",
 	)
 	cmd.Flags().Lookup("wait").NoOptDefVal = "Available" // Default when --wait is used without value
+	cmd.Flags().Bool(
+		"prune", false, "Delete ManifestWorks on the consumer that match --selector but are not in the applied set",
+	)
+	cmd.Flags().String(
+		"selector", "", "Label selector limiting which ManifestWorks --prune may delete (e.g., 'app=nginx')",
+	)
+	cmd.Flags().Bool("dry-run", false, "Show what would be applied and pruned without making changes")
+	cmd.Flags().Bool("yes", false, "Prune without asking for confirmation")
+	cmd.Flags().Bool("force", false, "Update even if the ManifestWork was modified since it was read")
+	cmd.Flags().Int("concurrency", defaultBulkConcurrency, "Maximum number of parallel delete requests when pruning")
+	cmd.Flags().Bool("validate", true,
+		"Check apiVersion, kind and metadata.name of the ManifestWork and each manifest before applying")
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("manifest-file"); err != nil {
@@ -122,30 +186,83 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 		Version:   "dev",
 	})
 
-	// Load ManifestWork from file
-	mw, err := manifestwork.LoadFromFile(flags.ManifestFile)
+	if flags.Wait != "" {
+		if err := maestro.ValidateConditionExpression(flags.Wait); err != nil {
+			return fmt.Errorf("invalid --wait: %w", err)
+		}
+	}
+
+	// Pruning without a selector would delete every other ManifestWork on the consumer
+	var pruneSelector labels.Selector
+	if flags.Prune {
+		if strings.TrimSpace(flags.Selector) == "" {
+			return fmt.Errorf("--prune requires --selector")
+		}
+		sel, err := labels.Parse(flags.Selector)
+		if err != nil {
+			return fmt.Errorf("invalid --selector: %w", err)
+		}
+		pruneSelector = sel
+	}
+
+	files, err := manifestwork.ResolveManifestFiles(flags.ManifestFile)
 	if err != nil {
-		log.Error(ctx, err, "Failed to load manifest file", logger.Fields{
-			"manifest_file": flags.ManifestFile,
-		})
-		return fmt.Errorf("failed to load manifest file: %w", err)
+		return fmt.Errorf("failed to resolve --manifest-file: %w", err)
+	}
+	log.Debug(ctx, "Resolved manifest files", logger.Fields{
+		"manifest_file": flags.ManifestFile,
+		"files":         strings.Join(files, ", "),
+	})
+
+	// Load every ManifestWork before touching the server
+	works := make([]*workv1.ManifestWork, 0, len(files))
+	for _, file := range files {
+		mw, err := manifestwork.LoadFromFile(file)
+		if err != nil {
+			log.Error(ctx, err, "Failed to load manifest file", logger.Fields{
+				"manifest_file": file,
+			})
+			return fmt.Errorf("failed to load manifest file: %w", err)
+		}
+
+		// Fail fast on structural mistakes instead of letting the server reject the work
+		if flags.Validate {
+			if problems := manifestwork.ValidateManifestWork(mw); len(problems) > 0 {
+				return fmt.Errorf("invalid ManifestWork in %s:\n  - %s", file, strings.Join(problems, "\n  - "))
+			}
+		}
+		works = append(works, mw)
+	}
+	// The results file describes a single ManifestWork
+	if len(works) > 1 && (flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != "") {
+		return fmt.Errorf("--results-path supports a single ManifestWork, but %s resolved to %d files",
+			flags.ManifestFile, len(files))
 	}
 
 	// Add cluster context for logging
 	ctx = logger.ContextWithClusterID(ctx, flags.Consumer)
-	ctx = logger.ContextWithResource(ctx, "manifestwork", mw.Name)
 
-	log.Info(ctx, "Loaded ManifestWork", logger.Fields{
-		"manifest_name": mw.Name,
-		"consumer":      flags.Consumer,
-		"manifests":     len(mw.Spec.Workload.Manifests),
-	})
+	names := make([]string, 0, len(works))
+	for _, mw := range works {
+		names = append(names, mw.Name)
+		log.Info(logger.ContextWithResource(ctx, "manifestwork", mw.Name), "Loaded ManifestWork", logger.Fields{
+			"manifest_name": mw.Name,
+			"consumer":      flags.Consumer,
+			"manifests":     len(mw.Spec.Workload.Manifests),
+		})
+	}
 
 	// Create Maestro client (passes context for proper signal handling)
 	client, err := maestro.NewClient(ctx, maestro.ClientConfig{
 		GRPCEndpoint:        flags.GRPCEndpoint,
 		HTTPEndpoint:        flags.HTTPEndpoint,
 		GRPCInsecure:        flags.GRPCInsecure,
+		ImpersonateUser:     flags.As,
+		ImpersonateGroups:   flags.AsGroups,
+		TLSMinVersion:       flags.TLSMinVersion,
+		APIPrefix:           flags.APIPrefix,
+		MaxRetries:          flags.MaxRetries,
+		RetryBackoff:        flags.RetryBackoff,
 		GRPCServerCAFile:    flags.GRPCServerCAFile,
 		GRPCBrokerCAFile:    flags.GRPCBrokerCAFile,
 		GRPCClientCertFile:  flags.GRPCClientCertFile,
@@ -153,6 +270,7 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 		GRPCClientToken:     flags.GRPCClientToken,
 		GRPCClientTokenFile: flags.GRPCClientTokenFile,
 		SourceID:            flags.SourceID,
+		Logger:              log,
 	})
 	if err != nil {
 		log.Error(ctx, err, "Failed to create Maestro client", logger.Fields{
@@ -175,9 +293,75 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 		return err
 	}
 
-	// Apply ManifestWork
-	applyResult, err := client.ApplyManifestWork(ctx, flags.Consumer, mw, log)
+	if flags.DryRun {
+		for _, mw := range works {
+			log.Info(ctx, "[DRY RUN] Would apply ManifestWork:", logger.Fields{
+				"manifest_name": mw.Name,
+				"consumer":      flags.Consumer,
+				"manifests":     len(mw.Spec.Workload.Manifests),
+			})
+		}
+		if flags.Prune {
+			return pruneManifestWorks(ctx, client, flags, pruneSelector, names, log)
+		}
+		return nil
+	}
+
+	for _, mw := range works {
+		workCtx := logger.ContextWithResource(ctx, "manifestwork", mw.Name)
+		if err := applyManifestWork(workCtx, client, flags, mw, log); err != nil {
+			return err
+		}
+	}
+
+	// Prune ManifestWorks that are no longer part of the applied set
+	if flags.Prune {
+		if err := pruneManifestWorks(ctx, client, flags, pruneSelector, names, log); err != nil {
+			return err
+		}
+	}
+
+	// Wait for condition if requested (using HTTP polling, like kubectl wait)
+	if flags.Wait != "" {
+		// Use timeout if specified, otherwise default to 5 minutes
+		waitTimeout := flags.Timeout
+		if waitTimeout == 0 {
+			waitTimeout = DefaultWaitTimeout
+		}
+
+		log.Info(ctx, "Waiting for condition", logger.Fields{
+			"condition": flags.Wait,
+			"timeout":   waitTimeout.String(),
+		})
+
+		// Create wait context with timeout, shared by every applied ManifestWork
+		waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
+		defer waitCancel()
+
+		for _, mw := range works {
+			if err := waitForAppliedManifestWork(waitCtx, client, flags, mw.Name, log); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyManifestWork creates or updates one ManifestWork and records the outcome
+// in the results file.
+func applyManifestWork(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *ApplyFlags,
+	mw *workv1.ManifestWork,
+	log *logger.Logger,
+) error {
+	applyResult, err := client.ApplyManifestWork(ctx, flags.Consumer, mw, flags.Force, log)
 	if err != nil {
+		if errors.IsConflict(err) {
+			err = fmt.Errorf("%w (use --force to apply anyway)", err)
+		}
 		if writeErr := manifestwork.WriteResult(flags.ResultsPath, manifestwork.StatusResult{
 			Name:      mw.Name,
 			Consumer:  flags.Consumer,
@@ -217,48 +401,131 @@ func runApplyCommand(ctx context.Context, flags *ApplyFlags) error {
 		})
 		return fmt.Errorf("failed to write results file: %w", writeErr)
 	}
+	return nil
+}
 
-	// Wait for condition if requested (using HTTP polling, like kubectl wait)
-	if flags.Wait != "" {
-		// Use timeout if specified, otherwise default to 5 minutes
-		waitTimeout := flags.Timeout
-		if waitTimeout == 0 {
-			waitTimeout = DefaultWaitTimeout
+// waitForAppliedManifestWork polls an applied ManifestWork until --wait is met,
+// updating the results file on each poll.
+func waitForAppliedManifestWork(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *ApplyFlags,
+	name string,
+	log *logger.Logger,
+) error {
+	// Create callback to update results file on each poll
+	var callback maestro.WaitCallback
+	if flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != "" {
+		callback = func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
+			status := "Waiting"
+			message := fmt.Sprintf("Waiting for condition '%s'", flags.Wait)
+			if conditionMet {
+				status = flags.Wait
+				message = fmt.Sprintf("Condition '%s' met", flags.Wait)
+			}
+			result := manifestwork.BuildStatusResult(name, flags.Consumer, status, message, details)
+			return manifestwork.WriteResult(flags.ResultsPath, result)
 		}
+	}
 
-		log.Info(ctx, "Waiting for condition", logger.Fields{
-			"condition": flags.Wait,
-			"timeout":   waitTimeout.String(),
-		})
+	// Poll every 2 seconds by default
+	return client.WaitForCondition(
+		ctx, flags.Consumer, name, flags.Wait, maestro.DefaultPollInterval, log, callback,
+	)
+}
 
-		// Create wait context with timeout
-		waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
-		defer waitCancel()
+// pruneManifestWorks deletes the consumer's ManifestWorks that match the selector
+// but are not in the applied set (keep). With --dry-run it only reports them.
+func pruneManifestWorks(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *ApplyFlags,
+	selector labels.Selector,
+	keep []string,
+	log *logger.Logger,
+) error {
+	works, err := client.ListManifestWorksHTTP(ctx, flags.Consumer)
+	if err != nil {
+		return fmt.Errorf("failed to list ManifestWorks for pruning: %w", err)
+	}
 
-		// Create callback to update results file on each poll
-		var callback maestro.WaitCallback
-		if flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != "" {
-			callback = func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
-				status := "Waiting"
-				message := fmt.Sprintf("Waiting for condition '%s'", flags.Wait)
-				if conditionMet {
-					status = flags.Wait
-					message = fmt.Sprintf("Condition '%s' met", flags.Wait)
-				}
-				result := manifestwork.BuildStatusResult(mw.Name, flags.Consumer, status, message, details)
-				return manifestwork.WriteResult(flags.ResultsPath, result)
-			}
+	var toDelete []string
+	for _, w := range works {
+		if slices.Contains(keep, w.Name) || !selector.Matches(labels.Set(w.Labels)) {
+			continue
+		}
+		toDelete = append(toDelete, w.Name)
+	}
+
+	if len(toDelete) == 0 {
+		log.Info(ctx, "Nothing to prune", logger.Fields{
+			"consumer": flags.Consumer,
+			"selector": flags.Selector,
+		})
+		return nil
+	}
+
+	if flags.DryRun {
+		for _, name := range toDelete {
+			log.Info(ctx, "[DRY RUN] Would prune ManifestWork:", logger.Fields{
+				"name":     name,
+				"consumer": flags.Consumer,
+			})
 		}
+		return nil
+	}
 
-		// Poll every 2 seconds by default
-		if err := client.WaitForCondition(
-			waitCtx, flags.Consumer, mw.Name, flags.Wait, maestro.DefaultPollInterval, log, callback,
-		); err != nil {
+	if !flags.Yes {
+		confirmed, err := confirmDeletion(flags.Consumer, toDelete)
+		if err != nil {
 			return err
 		}
+		if !confirmed {
+			log.Info(ctx, "Prune cancelled", logger.Fields{"consumer": flags.Consumer})
+			return nil
+		}
 	}
 
-	return nil
+	result := runBulk(ctx, toDelete, flags.Concurrency, func(ctx context.Context, name string) error {
+		log.Info(ctx, "Pruning ManifestWork", logger.Fields{
+			"name":     name,
+			"consumer": flags.Consumer,
+		})
+		return client.DeleteManifestWorkByNameHTTP(ctx, flags.Consumer, name)
+	})
+
+	log.Info(ctx, "Pruned ManifestWorks", logger.Fields{
+		"consumer":  flags.Consumer,
+		"succeeded": result.Succeeded,
+		"failed":    len(result.Failed),
+	})
+	return result.Err("prune")
+}
+
+// confirmDeletion asks on the terminal before deleting several ManifestWorks. It
+// refuses to guess when stdin is not interactive, so unattended runs must pass
+// --yes or --dry-run.
+func confirmDeletion(consumer string, names []string) (bool, error) {
+	if !isTerminal(os.Stdin) {
+		return false, fmt.Errorf("refusing to delete without confirmation: stdin is not a terminal (use --yes or --dry-run)")
+	}
+
+	fmt.Fprintf(os.Stderr, "The following ManifestWorks on consumer %q will be deleted:\n", consumer)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  - %s\n", name)
+	}
+	fmt.Fprint(os.Stderr, "Continue? [y/N]: ")
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		return false, fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
 }
 
 // getLogLevel determines the log level based on verbose flag
@@ -32,6 +32,12 @@ type BuildFlags struct {
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -105,6 +111,12 @@ Examples:
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -167,6 +179,12 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		Version:   "dev",
 	})
 
+	if flags.Wait != "" {
+		if err := maestro.ValidateConditionExpression(flags.Wait); err != nil {
+			return fmt.Errorf("invalid --wait: %w", err)
+		}
+	}
+
 	// Add context for logging
 	ctx = logger.ContextWithClusterID(ctx, flags.Consumer)
 	ctx = logger.ContextWithResource(ctx, "manifestwork", flags.Name)
@@ -189,6 +207,12 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		GRPCEndpoint:        flags.GRPCEndpoint,
 		HTTPEndpoint:        flags.HTTPEndpoint,
 		GRPCInsecure:        flags.GRPCInsecure,
+		ImpersonateUser:     flags.As,
+		ImpersonateGroups:   flags.AsGroups,
+		TLSMinVersion:       flags.TLSMinVersion,
+		APIPrefix:           flags.APIPrefix,
+		MaxRetries:          flags.MaxRetries,
+		RetryBackoff:        flags.RetryBackoff,
 		GRPCServerCAFile:    flags.GRPCServerCAFile,
 		GRPCBrokerCAFile:    flags.GRPCBrokerCAFile,
 		GRPCClientCertFile:  flags.GRPCClientCertFile,
@@ -196,6 +220,7 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		GRPCClientToken:     flags.GRPCClientToken,
 		GRPCClientTokenFile: flags.GRPCClientTokenFile,
 		SourceID:            flags.SourceID,
+		Logger:              log,
 	})
 	if err != nil {
 		log.Error(ctx, err, "Failed to create Maestro client", nil)
@@ -296,7 +321,7 @@ func runBuildCommand(ctx context.Context, flags *BuildFlags) error {
 		"consumer": flags.Consumer,
 	})
 
-	result, err := client.ApplyManifestWork(ctx, flags.Consumer, existing, log)
+	result, err := client.ApplyManifestWork(ctx, flags.Consumer, existing, false, log)
 	if err != nil {
 		writeErr := manifestwork.WriteResult(flags.ResultsPath, manifestwork.StatusResult{
 			Name:      existing.Name,
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// defaultBulkConcurrency is how many requests a bulk operation sends to the
+// server at once when --concurrency is not set.
+const defaultBulkConcurrency = 4
+
+// bulkFailure records one item of a bulk operation that failed.
+type bulkFailure struct {
+	Item string
+	Err  error
+}
+
+// bulkResult aggregates the outcome of a bulk operation.
+type bulkResult struct {
+	Total     int
+	Succeeded int
+	Failed    []bulkFailure // in input order
+}
+
+// Err returns nil when every item succeeded, otherwise an error naming the
+// failed items, e.g. "failed to delete 2 of 5 ManifestWorks: a: ...; b: ...".
+func (r bulkResult) Err(action string) error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(r.Failed))
+	for _, f := range r.Failed {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", f.Item, f.Err))
+	}
+	return fmt.Errorf("failed to %s %d of %d ManifestWorks: %s",
+		action, len(r.Failed), r.Total, strings.Join(msgs, "; "))
+}
+
+// runBulk calls op for every item using at most concurrency workers and
+// collects the per-item results. A concurrency below 1 means
+// defaultBulkConcurrency. Items not yet started when ctx is cancelled are
+// reported as failed with the context error.
+func runBulk(ctx context.Context, items []string, concurrency int, op func(context.Context, string) error) bulkResult {
+	if concurrency < 1 {
+		concurrency = defaultBulkConcurrency
+	}
+	if concurrency > len(items) {
+		concurrency = len(items)
+	}
+
+	errs := make([]error, len(items))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = op(ctx, items[i])
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	result := bulkResult{Total: len(items)}
+	for i, err := range errs {
+		if err != nil {
+			result.Failed = append(result.Failed, bulkFailure{Item: items[i], Err: err})
+		} else {
+			result.Succeeded++
+		}
+	}
+	return result
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/config"
+)
+
+// NewConfigCommand creates the config command, which views and edits the
+// configuration files
+func NewConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "View and set configuration defaults",
+		Long: `View and set the defaults kept in the maestro-cli config file, without
+editing YAML by hand.
+
+MAESTRO_CONFIG lists the files to merge, separated like PATH, in place of
+~/.config/maestro-cli/config.yaml. Later files override keys set by earlier
+ones. set and use-context write to the last of them (or --file), keeping its
+comments.
+
+Examples:
+  # Show the merged configuration
+  maestro-cli config view
+
+  # Define a context and switch to it
+  maestro-cli config set context.staging.endpoint=https://maestro-staging.example.com
+  maestro-cli config use-context staging
+
+  # Default get, list and describe to JSON
+  maestro-cli config set output=json`,
+	}
+
+	cmd.PersistentFlags().String("file", "", "Config file to modify (default: the last file in MAESTRO_CONFIG, "+
+		"or ~/.config/maestro-cli/config.yaml)")
+
+	cmd.AddCommand(
+		newConfigViewCommand(),
+		newConfigSetCommand(),
+		newConfigUseContextCommand(),
+	)
+	return cmd
+}
+
+// configWritePath returns the config file that set and use-context modify:
+// --file, or else the file that takes precedence in the merge
+func configWritePath(cmd *cobra.Command) (string, error) {
+	if path := getStringFlag(cmd, "file"); path != "" {
+		return path, nil
+	}
+	paths := config.Paths()
+	if len(paths) == 0 {
+		return "", fmt.Errorf("no config file location found: set --file or %s", config.EnvConfig)
+	}
+	return paths[len(paths)-1], nil
+}
+
+func newConfigSetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set KEY=VALUE",
+		Short: "Set a configuration value",
+		Long: `Set a key in the config file. An empty value removes the key.
+
+Keys:
+  output                    Default --output of get, list and describe (json, yaml, table)
+  endpoints                 Comma-separated endpoints offered on the TUI connect screen
+  current-context           Context whose settings apply (see use-context)
+  context.<name>.endpoint   Maestro HTTP endpoint of a context
+
+The file is validated before it is written, and its comments are kept.
+
+Examples:
+  maestro-cli config set context.prod.endpoint=https://maestro-prod.example.com
+  maestro-cli config set endpoints=http://maestro-a:8000,http://maestro-b:8000
+  maestro-cli config set output=`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, value, ok := strings.Cut(args[0], "=")
+			switch {
+			case len(args) == 2 && !ok:
+				value = args[1]
+			case len(args) == 2 || !ok:
+				return fmt.Errorf("expected KEY=VALUE, got %q", strings.Join(args, " "))
+			}
+			path, err := configWritePath(cmd)
+			if err != nil {
+				return err
+			}
+			if err := config.Set(path, key, value); err != nil {
+				return err
+			}
+			if value == "" {
+				fmt.Printf("Removed %s from %s\n", key, path)
+				return nil
+			}
+			fmt.Printf("Set %s in %s\n", key, path)
+			return nil
+		},
+	}
+}
+
+func newConfigUseContextCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context NAME",
+		Short: "Switch the current context",
+		Long: `Set current-context to NAME, which must be defined under contexts in one of
+the config files. Its endpoint then applies whenever neither --http-endpoint nor
+MAESTRO_HTTP_ENDPOINT is set.
+
+Examples:
+  maestro-cli config use-context staging`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			cfg, err := config.LoadDefault()
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.Contexts[name]; !ok {
+				names := make([]string, 0, len(cfg.Contexts))
+				for n := range cfg.Contexts {
+					names = append(names, n)
+				}
+				slices.Sort(names)
+				if len(names) == 0 {
+					return fmt.Errorf("context %q not found: no contexts are defined "+
+						"(add one with config set context.%s.endpoint=URL)", name, name)
+				}
+				return fmt.Errorf("context %q not found (available: %s)", name, strings.Join(names, ", "))
+			}
+			path, err := configWritePath(cmd)
+			if err != nil {
+				return err
+			}
+			if err := config.Set(path, "current-context", name); err != nil {
+				return err
+			}
+			fmt.Printf("Switched to context %q\n", name)
+			return nil
+		},
+	}
+}
+
+func newConfigViewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view",
+		Short: "Print the merged configuration and the files it came from",
+		Long: `Print the configuration every command uses, after merging the config files,
+preceded by the files read in merge order.
+
+Examples:
+  # Show what an org-wide file and a personal override resolve to
+  MAESTRO_CONFIG=/etc/maestro-cli/config.yaml:$HOME/.maestro.yaml maestro-cli config view`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			paths := config.Paths()
+			cfg, err := config.LoadFiles(paths)
+			if err != nil {
+				return err
+			}
+			data, err := yaml.Marshal(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to marshal config: %w", err)
+			}
+
+			fmt.Println("# Merged from:")
+			for _, path := range paths {
+				if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+					fmt.Printf("#   %s (not found)\n", path)
+					continue
+				}
+				fmt.Printf("#   %s\n", path)
+			}
+			fmt.Print(string(data))
+			return nil
+		},
+	}
+}
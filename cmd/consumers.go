@@ -0,0 +1,311 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// ConsumerCreateFlags contains flags for the consumers create command
+type ConsumerCreateFlags struct {
+	Name   string
+	Labels string // Comma-separated key=value pairs
+	// Global flags
+	HTTPEndpoint  string
+	GRPCInsecure  bool
+	As            string        // User to impersonate on HTTP requests
+	AsGroups      []string      // Groups to impersonate, with As
+	TLSMinVersion string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix     string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries    int           // Retries of a failed idempotent HTTP request
+	RetryBackoff  time.Duration // Wait before the first retry, doubling after
+	Output        string
+	Timeout       time.Duration
+	Verbose       bool
+}
+
+// ConsumerUpdateFlags contains flags for the consumers update command
+type ConsumerUpdateFlags struct {
+	Name   string
+	Labels string // Comma-separated key=value pairs to set and key- entries to remove
+	// Global flags
+	HTTPEndpoint  string
+	GRPCInsecure  bool
+	As            string        // User to impersonate on HTTP requests
+	AsGroups      []string      // Groups to impersonate, with As
+	TLSMinVersion string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix     string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries    int           // Retries of a failed idempotent HTTP request
+	RetryBackoff  time.Duration // Wait before the first retry, doubling after
+	Output        string
+	Timeout       time.Duration
+	Verbose       bool
+}
+
+// NewConsumersCommand creates the consumers parent command
+func NewConsumersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumers",
+		Short: "Manage Maestro consumers (target clusters)",
+		Long: `Manage Maestro consumers. A consumer represents a target cluster that
+ManifestWorks are delivered to.`,
+	}
+
+	cmd.AddCommand(NewConsumersCreateCommand(), NewConsumersUpdateCommand())
+
+	return cmd
+}
+
+// NewConsumersCreateCommand creates the consumers create command
+func NewConsumersCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a consumer",
+		Long: `Create a new Maestro consumer, optionally tagged with labels.
+
+Labels are stored on the consumer and can be used to group consumers by
+cluster, region or team. Maestro consumers do not support annotations.
+
+Examples:
+  # Create a consumer
+  maestro-cli consumers create --name=cluster-west-1
+
+  # Create a consumer with labels
+  maestro-cli consumers create --name=cluster-west-1 --labels=region=us-west,env=prod`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			flags := &ConsumerCreateFlags{
+				Name:   getStringFlag(cmd, "name"),
+				Labels: getStringFlag(cmd, "labels"),
+				// Global flags
+				HTTPEndpoint:  getStringFlag(cmd, "http-endpoint"),
+				GRPCInsecure:  getBoolFlag(cmd, "grpc-insecure"),
+				As:            getStringFlag(cmd, "as"),
+				AsGroups:      getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion: getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:     getStringFlag(cmd, "api-prefix"),
+				MaxRetries:    getIntFlag(cmd, "max-retries"),
+				RetryBackoff:  getDurationFlag(cmd, "retry-backoff"),
+				Output:        getStringFlag(cmd, "output"),
+				Timeout:       getDurationFlag(cmd, "timeout"),
+				Verbose:       getBoolFlag(cmd, "verbose"),
+			}
+
+			return runConsumersCreateCommand(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().String("name", "", "Consumer name (required)")
+	cmd.Flags().String("labels", "", "Comma-separated labels to set on the consumer (e.g., 'region=us-west,env=prod')")
+
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// runConsumersCreateCommand executes the consumers create command
+func runConsumersCreateCommand(ctx context.Context, flags *ConsumerCreateFlags) error {
+	// Setup context with timeout if specified
+	if flags.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, flags.Timeout)
+		defer cancel()
+	}
+
+	log := logger.New(logger.Config{Level: getLogLevel(flags.Verbose), Format: "text"})
+
+	labels, err := maestro.ParseLabels(flags.Labels)
+	if err != nil {
+		return fmt.Errorf("invalid --labels: %w", err)
+	}
+
+	// Create HTTP-only client (consumers are managed through the HTTP API)
+	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create Maestro client: %w", err)
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			log.Warn(ctx, "Failed to close client", logger.Fields{"error": err.Error()})
+		}
+	}()
+
+	consumer, err := client.CreateConsumer(ctx, flags.Name, labels)
+	if err != nil {
+		return err
+	}
+
+	log.Debug(ctx, "Consumer created", logger.Fields{
+		"name": consumer.Name,
+		"id":   consumer.ID,
+	})
+
+	switch strings.ToLower(flags.Output) {
+	case defaultOutputFormatJSON:
+		data, err := json.MarshalIndent(consumer, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	default: // yaml
+		data, err := yaml.Marshal(consumer)
+		if err != nil {
+			return fmt.Errorf("failed to marshal YAML: %w", err)
+		}
+		fmt.Println(string(data))
+	}
+
+	return nil
+}
+
+// NewConsumersUpdateCommand creates the consumers update command
+func NewConsumersUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a consumer's labels",
+		Long: `Set or remove labels on an existing Maestro consumer.
+
+--labels takes key=value pairs to add or change and key- entries to remove,
+like kubectl label; labels not mentioned are kept. Maestro can only update a
+consumer's labels: consumers cannot be renamed.
+
+Examples:
+  # Add or change labels
+  maestro-cli consumers update --name=cluster-west-1 --labels=env=staging,team=platform
+
+  # Remove a label
+  maestro-cli consumers update --name=cluster-west-1 --labels=team-`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			flags := &ConsumerUpdateFlags{
+				Name:   getStringFlag(cmd, "name"),
+				Labels: getStringFlag(cmd, "labels"),
+				// Global flags
+				HTTPEndpoint:  getStringFlag(cmd, "http-endpoint"),
+				GRPCInsecure:  getBoolFlag(cmd, "grpc-insecure"),
+				As:            getStringFlag(cmd, "as"),
+				AsGroups:      getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion: getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:     getStringFlag(cmd, "api-prefix"),
+				MaxRetries:    getIntFlag(cmd, "max-retries"),
+				RetryBackoff:  getDurationFlag(cmd, "retry-backoff"),
+				Output:        getStringFlag(cmd, "output"),
+				Timeout:       getDurationFlag(cmd, "timeout"),
+				Verbose:       getBoolFlag(cmd, "verbose"),
+			}
+
+			return runConsumersUpdateCommand(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().String("name", "", "Consumer name (required)")
+	cmd.Flags().String("labels", "", "Labels to set (key=value) or remove (key-), comma-separated (required)")
+
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("labels"); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// runConsumersUpdateCommand executes the consumers update command
+func runConsumersUpdateCommand(ctx context.Context, flags *ConsumerUpdateFlags) error {
+	// Setup context with timeout if specified
+	if flags.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, flags.Timeout)
+		defer cancel()
+	}
+
+	log := logger.New(logger.Config{Level: getLogLevel(flags.Verbose), Format: "text"})
+
+	set, remove, err := maestro.ParseLabelChanges(flags.Labels)
+	if err != nil {
+		return fmt.Errorf("invalid --labels: %w", err)
+	}
+
+	// Create HTTP-only client (consumers are managed through the HTTP API)
+	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create Maestro client: %w", err)
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			log.Warn(ctx, "Failed to close client", logger.Fields{"error": err.Error()})
+		}
+	}()
+
+	existing, err := client.GetConsumerByName(ctx, flags.Name)
+	if err != nil {
+		return err
+	}
+
+	labels := make(map[string]string, len(existing.Labels)+len(set))
+	for k, v := range existing.Labels {
+		labels[k] = v
+	}
+	for _, k := range remove {
+		delete(labels, k)
+	}
+	for k, v := range set {
+		labels[k] = v
+	}
+
+	consumer, err := client.UpdateConsumerLabels(ctx, existing.ID, labels)
+	if err != nil {
+		return err
+	}
+
+	log.Debug(ctx, "Consumer labels updated", logger.Fields{
+		"name":   consumer.Name,
+		"labels": maestro.FormatLabels(consumer.Labels),
+	})
+
+	switch strings.ToLower(flags.Output) {
+	case defaultOutputFormatJSON:
+		data, err := json.MarshalIndent(consumer, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	default: // yaml
+		data, err := yaml.Marshal(consumer)
+		if err != nil {
+			return fmt.Errorf("failed to marshal YAML: %w", err)
+		}
+		fmt.Println(string(data))
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/internal/manifestwork"
@@ -15,14 +16,24 @@ import (
 
 // DeleteFlags contains flags for the delete command
 type DeleteFlags struct {
-	Name     string // Original ManifestWork name (metadata.name)
-	Consumer string
-	Wait     bool // Wait for deletion completion
-	DryRun   bool
+	Name        string // Original ManifestWork name (metadata.name)
+	Consumer    string
+	Wait        bool // Wait for deletion completion
+	DryRun      bool
+	Selector    string // Label selector; deletes every matching ManifestWork instead of --name
+	Yes         bool   // Skip the confirmation prompt for selector deletes
+	Concurrency int    // Parallel requests for selector deletes
+	Force       bool   // Delete even if the ManifestWork changed since it was read
 	// Global flags
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -45,6 +56,8 @@ func NewDeleteCommand() *cobra.Command {
 
 Use --name with the original metadata.name from your ManifestWork file.
 Use 'maestro-cli list' to see available ManifestWorks and their names.
+Use --selector instead of --name to delete every ManifestWork whose labels
+match; up to --concurrency requests are sent in parallel.
 
 Note: Maestro does not support removing individual manifests from a ManifestWork.
 To remove specific manifests, delete the entire ManifestWork and re-apply with
@@ -58,17 +71,30 @@ Examples:
   maestro-cli delete --name=my-manifestwork --consumer=cluster-west-1 --wait
 
   # Dry run to see what would be deleted
-  maestro-cli delete --name=nginx-work --consumer=cluster-west-1 --dry-run`,
+  maestro-cli delete --name=nginx-work --consumer=cluster-west-1 --dry-run
+
+  # Delete every ManifestWork labelled app=nginx, 8 at a time, without prompting
+  maestro-cli delete --selector=app=nginx --consumer=cluster-west-1 --concurrency=8 --yes`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			flags := &DeleteFlags{
-				Name:     getStringFlag(cmd, "name"),
-				Consumer: getStringFlag(cmd, "consumer"),
-				Wait:     getBoolFlag(cmd, "wait"),
-				DryRun:   getBoolFlag(cmd, "dry-run"),
+				Name:        getStringFlag(cmd, "name"),
+				Consumer:    getStringFlag(cmd, "consumer"),
+				Wait:        getBoolFlag(cmd, "wait"),
+				DryRun:      getBoolFlag(cmd, "dry-run"),
+				Selector:    getStringFlag(cmd, "selector"),
+				Yes:         getBoolFlag(cmd, "yes"),
+				Concurrency: getIntFlag(cmd, "concurrency"),
+				Force:       getBoolFlag(cmd, "force"),
 				// Global flags
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -92,10 +118,14 @@ Examples:
 	cmd.Flags().Bool("wait", false, "Wait for deletion completion (like kubectl wait --for=delete)")
 	cmd.Flags().Bool("dry-run", false, "Show what would be deleted without making changes")
 
+	cmd.Flags().String("selector", "", "Delete every ManifestWork matching this label selector (e.g., 'app=nginx')")
+	cmd.Flags().Bool("yes", false, "Delete by selector without asking for confirmation")
+	cmd.Flags().Bool("force", false, "Delete even if the ManifestWork was modified since it was read")
+	cmd.Flags().Int("concurrency", defaultBulkConcurrency, "Maximum number of parallel delete requests with --selector")
+
 	// Mark required flags
-	if err := cmd.MarkFlagRequired("name"); err != nil {
-		panic(err)
-	}
+	cmd.MarkFlagsOneRequired("name", "selector")
+	cmd.MarkFlagsMutuallyExclusive("name", "selector")
 	if err := cmd.MarkFlagRequired("consumer"); err != nil {
 		panic(err)
 	}
@@ -117,8 +147,15 @@ func runDeleteCommand(ctx context.Context, flags *DeleteFlags) error {
 
 	// Create HTTP-only client (no gRPC needed for delete)
 	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
@@ -134,10 +171,124 @@ func runDeleteCommand(ctx context.Context, flags *DeleteFlags) error {
 		return err
 	}
 
+	if flags.Selector != "" {
+		selector, err := labels.Parse(flags.Selector)
+		if err != nil {
+			return fmt.Errorf("invalid --selector: %w", err)
+		}
+		return deleteManifestWorksBySelector(ctx, client, flags, selector, log)
+	}
+
 	// Handle ManifestWork deletion
 	return deleteManifestWork(ctx, client, flags, log)
 }
 
+// deleteManifestWorksBySelector deletes every ManifestWork on the consumer whose
+// labels match selector, using a bounded number of parallel requests. Unless
+// forced, each delete is refused if the ManifestWork changed after it was listed.
+func deleteManifestWorksBySelector(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *DeleteFlags,
+	selector labels.Selector,
+	log *logger.Logger,
+) error {
+	works, err := client.ListManifestWorksHTTP(ctx, flags.Consumer)
+	if err != nil {
+		return fmt.Errorf("failed to list ManifestWorks: %w", err)
+	}
+
+	var names []string
+	matched := map[string]maestro.ResourceBundleSummary{}
+	for _, w := range works {
+		if selector.Matches(labels.Set(w.Labels)) {
+			names = append(names, w.Name)
+			matched[w.Name] = w
+		}
+	}
+
+	if len(names) == 0 {
+		log.Warn(ctx, "No ManifestWorks match the selector, nothing to delete", logger.Fields{
+			"selector": flags.Selector,
+			"consumer": flags.Consumer,
+		})
+		return nil
+	}
+
+	if flags.DryRun {
+		for _, name := range names {
+			log.Info(ctx, "[DRY RUN] Would delete ManifestWork:", logger.Fields{
+				"name":     name,
+				"consumer": flags.Consumer,
+			})
+		}
+		return nil
+	}
+
+	if !flags.Yes {
+		confirmed, err := confirmDeletion(flags.Consumer, names)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info(ctx, "Delete cancelled", logger.Fields{"consumer": flags.Consumer})
+			return nil
+		}
+	}
+
+	waitTimeout := flags.Timeout
+	if waitTimeout == 0 {
+		waitTimeout = DefaultWaitTimeout
+	}
+
+	result := runBulk(ctx, names, flags.Concurrency, func(ctx context.Context, name string) error {
+		log.Info(ctx, "Deleting ManifestWork", logger.Fields{
+			"name":     name,
+			"consumer": flags.Consumer,
+		})
+		work := matched[name]
+		expectedVersion := work.Version
+		if flags.Force {
+			expectedVersion = 0
+		}
+		if err := client.DeleteResourceBundleByID(ctx, work.ID, expectedVersion); err != nil {
+			if errors.IsConflict(err) {
+				return fmt.Errorf("%w (use --force to delete anyway)", err)
+			}
+			return err
+		}
+		if !flags.Wait {
+			return nil
+		}
+		waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
+		defer waitCancel()
+		return client.WaitForDeletion(waitCtx, flags.Consumer, name, maestro.DefaultPollInterval, log)
+	})
+
+	log.Info(ctx, "Deleted ManifestWorks", logger.Fields{
+		"selector":  flags.Selector,
+		"consumer":  flags.Consumer,
+		"succeeded": result.Succeeded,
+		"failed":    len(result.Failed),
+	})
+
+	status, message := "Deleted", fmt.Sprintf("%d ManifestWork(s) deleted", result.Succeeded)
+	if len(result.Failed) > 0 {
+		status = "Failed"
+		message = fmt.Sprintf("%d of %d ManifestWork(s) failed to delete", len(result.Failed), result.Total)
+	}
+	if err := manifestwork.WriteResult(flags.ResultsPath, manifestwork.StatusResult{
+		Name:      flags.Selector,
+		Consumer:  flags.Consumer,
+		Status:    status,
+		Message:   message,
+		Timestamp: time.Now(),
+	}); err != nil {
+		return err
+	}
+	return result.Err("delete")
+}
+
 // deleteManifestWork deletes an entire ManifestWork
 func deleteManifestWork(ctx context.Context, client *maestro.Client, flags *DeleteFlags, log *logger.Logger) error {
 	// Check if the ManifestWork exists using HTTP API (doesn't require gRPC subscription)
@@ -178,7 +329,15 @@ func deleteManifestWork(ctx context.Context, client *maestro.Client, flags *Dele
 		"consumer": flags.Consumer,
 	})
 
-	if err := client.DeleteManifestWorkByNameHTTP(ctx, flags.Consumer, flags.Name); err != nil {
+	// Unless forced, refuse to delete if the ManifestWork changed after it was read
+	expectedVersion := work.Version
+	if flags.Force {
+		expectedVersion = 0
+	}
+	if err := client.DeleteResourceBundleByID(ctx, work.ID, expectedVersion); err != nil {
+		if errors.IsConflict(err) {
+			return fmt.Errorf("%w (use --force to delete anyway)", err)
+		}
 		return fmt.Errorf("failed to delete ManifestWork: %w", err)
 	}
 
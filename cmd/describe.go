@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	"sigs.k8s.io/yaml"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
@@ -18,10 +20,17 @@ import (
 type DescribeFlags struct {
 	Name     string
 	Consumer string
+	Interval time.Duration
 	// Global flags
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -46,15 +55,29 @@ Examples:
   maestro-cli describe --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1
 
   # Describe with JSON output
-  maestro-cli describe --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1 --output=json`,
+  maestro-cli describe --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1 --output=json
+
+  # Refresh the view every 5 seconds until Ctrl+C
+  maestro-cli describe --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1 --interval=5s`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, err := resolveOutput(cmd)
+			if err != nil {
+				return err
+			}
 			flags := &DescribeFlags{
 				Name:     getStringFlag(cmd, "name"),
 				Consumer: getStringFlag(cmd, "consumer"),
+				Interval: getDurationFlag(cmd, "interval"),
 				// Global flags
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -62,7 +85,7 @@ Examples:
 				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
 				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
 				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
+				Output:              output,
 				Timeout:             getDurationFlag(cmd, "timeout"),
 				Verbose:             getBoolFlag(cmd, "verbose"),
 			}
@@ -74,6 +97,8 @@ Examples:
 	// Command-specific flags
 	cmd.Flags().String("name", "", "ManifestWork name (required)")
 	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().Duration("interval", 0,
+		"Re-render the description at this interval until interrupted (0 describes once)")
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("name"); err != nil {
@@ -88,6 +113,10 @@ Examples:
 
 // runDescribeCommand executes the describe command
 func runDescribeCommand(ctx context.Context, flags *DescribeFlags) error {
+	if flags.Interval < 0 {
+		return fmt.Errorf("--interval must not be negative")
+	}
+
 	// Set up context with timeout
 	if flags.Timeout > 0 {
 		var cancel context.CancelFunc
@@ -103,8 +132,15 @@ func runDescribeCommand(ctx context.Context, flags *DescribeFlags) error {
 
 	// Create HTTP-only client (no gRPC needed for describe)
 	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
@@ -125,20 +161,85 @@ func runDescribeCommand(ctx context.Context, flags *DescribeFlags) error {
 		"consumer": flags.Consumer,
 	})
 
+	if flags.Interval > 0 {
+		return refreshDescribe(ctx, client, flags, log)
+	}
+
 	// Get the full ManifestWork details
 	details, err := client.GetManifestWorkDetailsHTTP(ctx, flags.Consumer, flags.Name)
 	if err != nil {
 		return err
 	}
 
-	// Output based on format
-	switch strings.ToLower(flags.Output) {
+	return outputDescribe(details, flags.Output)
+}
+
+// refreshDescribe re-renders the description every --interval until ctx is
+// cancelled by Ctrl+C or --timeout. On a terminal each render replaces the
+// previous one, like watch(1); otherwise renders are appended, so the output
+// can be piped or logged. A failed fetch is reported and retried on the next
+// tick rather than ending the refresh.
+func refreshDescribe(ctx context.Context, client *maestro.Client, flags *DescribeFlags, log *logger.Logger) error {
+	clearScreen := isTerminal(os.Stdout)
+
+	ticker := time.NewTicker(flags.Interval)
+	defer ticker.Stop()
+
+	for first := true; ; first = false {
+		details, err := client.GetManifestWorkDetailsHTTP(ctx, flags.Consumer, flags.Name)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if clearScreen {
+			fmt.Print("\033[H\033[2J")
+		} else if !first {
+			fmt.Println()
+		}
+		fmt.Printf("Every %s: describe %s (consumer %s)    %s\n\n",
+			flags.Interval, flags.Name, flags.Consumer, time.Now().Format(time.RFC3339))
+		if err != nil {
+			log.Warn(ctx, "Failed to fetch ManifestWork details", logger.Fields{"error": err.Error()})
+		} else if err := outputDescribe(details, flags.Output); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// isTerminal reports whether f is an interactive terminal
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// terminalWidth returns the width in columns of the terminal f writes to, or
+// 0 when f is not a terminal, so piped output is never wrapped.
+func terminalWidth(f *os.File) int {
+	if !isTerminal(f) {
+		return 0
+	}
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// outputDescribe renders details in the requested --output format
+func outputDescribe(details *maestro.ManifestWorkDetails, output string) error {
+	switch strings.ToLower(output) {
 	case defaultOutputFormatJSON:
 		return outputDescribeJSON(details)
 	case defaultOutputFormatYAML:
 		return outputDescribeYAML(details)
 	default:
-		outputDescribeHuman(details)
+		outputDescribeHuman(details, terminalWidth(os.Stdout))
 		return nil
 	}
 }
@@ -163,14 +264,21 @@ func outputDescribeYAML(details *maestro.ManifestWorkDetails) error {
 	return nil
 }
 
-// outputDescribeHuman outputs ManifestWork details in human-readable format
-func outputDescribeHuman(details *maestro.ManifestWorkDetails) {
+// outputDescribeHuman outputs ManifestWork details in human-readable format.
+// A width above zero soft-wraps condition messages to that many columns.
+func outputDescribeHuman(details *maestro.ManifestWorkDetails, width int) {
 	fmt.Printf("Name:         %s\n", details.Name)
 	fmt.Printf("ID:           %s\n", details.ID)
 	fmt.Printf("Consumer:     %s\n", details.ConsumerName)
 	fmt.Printf("Version:      %d\n", details.Version)
 	fmt.Printf("Created:      %s\n", details.CreatedAt)
 	fmt.Printf("Updated:      %s\n", details.UpdatedAt)
+	if details.DeletedAt != "" {
+		fmt.Printf("Deleting:     since %s\n", details.DeletedAt)
+	}
+	if details.SourceID != "" {
+		fmt.Printf("Source:       %s\n", details.SourceID)
+	}
 
 	// Conditions
 	fmt.Printf("\nConditions:\n")
@@ -184,7 +292,7 @@ func outputDescribeHuman(details *maestro.ManifestWorkDetails) {
 				fmt.Printf("    Reason:  %s\n", cond.Reason)
 			}
 			if cond.Message != "" {
-				fmt.Printf("    Message: %s\n", cond.Message)
+				printHanging("    Message: ", cond.Message, width)
 			}
 			if cond.LastTransitionTime != "" {
 				fmt.Printf("    LastTransitionTime: %s\n", cond.LastTransitionTime)
@@ -202,10 +310,13 @@ func outputDescribeHuman(details *maestro.ManifestWorkDetails) {
 	if len(details.ResourceStatus) > 0 {
 		fmt.Printf("\nResource Status:\n")
 		for _, rs := range details.ResourceStatus {
-			fmt.Printf("  %s/%s:\n", rs.Kind, rs.Name)
+			fmt.Printf("  %s:\n", rs.DisplayName())
 			if rs.Namespace != "" {
 				fmt.Printf("    Namespace: %s\n", rs.Namespace)
 			}
+			if len(rs.Conditions) == 0 {
+				fmt.Printf("    (no conditions)\n")
+			}
 			for _, cond := range rs.Conditions {
 				fmt.Printf("    %s: %s\n", cond.Type, cond.Status)
 			}
@@ -223,3 +334,23 @@ func outputDescribeHuman(details *maestro.ManifestWorkDetails) {
 		fmt.Printf("\nDelete Option: %s\n", details.DeleteOption)
 	}
 }
+
+// minWrapWidth is the narrowest column a message is wrapped into; below it the
+// message is printed on one line rather than a word per line.
+const minWrapWidth = 20
+
+// printHanging prints prefix followed by text soft-wrapped to width columns,
+// with continuation lines indented to align under the start of text.
+func printHanging(prefix, text string, width int) {
+	indent := len(prefix)
+	if width-indent < minWrapWidth {
+		fmt.Printf("%s%s\n", prefix, text)
+		return
+	}
+	for i, line := range maestro.WrapText(text, width-indent) {
+		if i > 0 {
+			prefix = strings.Repeat(" ", indent)
+		}
+		fmt.Println(strings.TrimRight(prefix+line, " "))
+	}
+}
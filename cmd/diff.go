@@ -26,6 +26,12 @@ type DiffFlags struct {
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -59,6 +65,12 @@ Examples:
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -115,8 +127,15 @@ func runDiffCommand(ctx context.Context, flags *DiffFlags) error {
 
 	// Create HTTP-only client
 	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
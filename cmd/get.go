@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -18,10 +17,22 @@ import (
 type GetFlags struct {
 	Name     string
 	Consumer string
+	// Render as this API version instead of the raw resource bundle (empty = raw)
+	OutputVersion string
+	// Print JSON on a single line instead of indented
+	Compact bool
+	// Fetch only this part of the resource bundle (empty = all of it)
+	Subresource string
 	// Global flags
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -46,15 +57,38 @@ Examples:
   maestro-cli get --name=hyperfleet-cluster-west-1-job --consumer=agent1
 
   # Get with JSON output
-  maestro-cli get --name=hyperfleet-cluster-west-1-job --consumer=agent1 --output=json`,
+  maestro-cli get --name=hyperfleet-cluster-west-1-job --consumer=agent1 --output=json
+
+  # Get as single-line JSON for piping
+  maestro-cli get --name=hyperfleet-cluster-west-1-job --consumer=agent1 --output=json --compact | jq -c .metadata
+
+  # Get a clean ManifestWork (no status or server fields) to check into git and re-apply
+  maestro-cli get --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
+    --output-version=work.open-cluster-management.io/v1 > job-manifestwork.yaml
+
+  # Get only the reconciliation status, without the manifests
+  maestro-cli get --name=hyperfleet-cluster-west-1-job --consumer=agent1 --subresource=status`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, err := resolveOutput(cmd)
+			if err != nil {
+				return err
+			}
 			flags := &GetFlags{
-				Name:     getStringFlag(cmd, "name"),
-				Consumer: getStringFlag(cmd, "consumer"),
+				Name:          getStringFlag(cmd, "name"),
+				Consumer:      getStringFlag(cmd, "consumer"),
+				OutputVersion: getStringFlag(cmd, "output-version"),
+				Compact:       getBoolFlag(cmd, "compact"),
+				Subresource:   getStringFlag(cmd, "subresource"),
 				// Global flags
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -62,7 +96,7 @@ Examples:
 				GRPCClientToken:     getStringFlag(cmd, "grpc-client-token"),
 				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
 				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
+				Output:              output,
 				Timeout:             getDurationFlag(cmd, "timeout"),
 				Verbose:             getBoolFlag(cmd, "verbose"),
 			}
@@ -74,6 +108,13 @@ Examples:
 	// Command-specific flags
 	cmd.Flags().String("name", "", "ManifestWork name (required)")
 	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("output-version", "",
+		"Render as a re-appliable "+maestro.OutputVersionManifestWork+
+			" ManifestWork, without status and server-populated fields (default: the resource bundle as stored)")
+	cmd.Flags().Bool("compact", false, "With --output=json, print the JSON on a single line instead of indented")
+	cmd.Flags().String("subresource", "",
+		"Fetch only part of the resource bundle: status (its status and identifying fields, without the manifests)")
+	cmd.MarkFlagsMutuallyExclusive("output-version", "subresource")
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("name"); err != nil {
@@ -88,6 +129,16 @@ Examples:
 
 // runGetCommand executes the get command
 func runGetCommand(ctx context.Context, flags *GetFlags) error {
+	if err := maestro.ValidateOutputVersion(flags.OutputVersion); err != nil {
+		return fmt.Errorf("invalid --output-version: %w", err)
+	}
+	if err := maestro.ValidateSubresource(flags.Subresource); err != nil {
+		return fmt.Errorf("invalid --subresource: %w", err)
+	}
+	if flags.Compact && strings.ToLower(flags.Output) != "json" {
+		return fmt.Errorf("--compact requires --output=json")
+	}
+
 	// Setup context with timeout if specified
 	if flags.Timeout > 0 {
 		var cancel context.CancelFunc
@@ -103,8 +154,15 @@ func runGetCommand(ctx context.Context, flags *GetFlags) error {
 
 	// Create HTTP-only client (no gRPC needed for get)
 	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
@@ -126,17 +184,34 @@ func runGetCommand(ctx context.Context, flags *GetFlags) error {
 	})
 
 	// Get the ManifestWork
-	rb, err := client.GetResourceBundleFullHTTP(ctx, flags.Consumer, flags.Name)
-	if err != nil {
-		return err
+	var rb interface{}
+	switch {
+	case flags.OutputVersion != "":
+		raw, err := client.GetResourceBundleRawHTTP(ctx, flags.Consumer, flags.Name)
+		if err != nil {
+			return err
+		}
+		rb = maestro.NormalizeManifestWork(raw)
+	case flags.Subresource == maestro.SubresourceStatus:
+		status, err := client.GetResourceBundleStatusHTTP(ctx, flags.Consumer, flags.Name, log)
+		if err != nil {
+			return err
+		}
+		rb = status
+	default:
+		full, err := client.GetResourceBundleFullHTTP(ctx, flags.Consumer, flags.Name)
+		if err != nil {
+			return err
+		}
+		rb = full
 	}
 
 	// Output based on format
 	switch strings.ToLower(flags.Output) {
 	case "json":
-		data, err := json.MarshalIndent(rb, "", "  ")
+		data, err := marshalJSON(rb, flags.Compact)
 		if err != nil {
-			return fmt.Errorf("failed to marshal JSON: %w", err)
+			return err
 		}
 		fmt.Println(string(data))
 	default: // yaml
@@ -2,9 +2,13 @@ package cmd
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -16,12 +20,30 @@ import (
 
 // ListFlags contains flags for the list command
 type ListFlags struct {
-	Consumer string
-	Filter   string // Filter by manifest content (kind, name, or kind/name)
+	Consumer     string
+	AllConsumers bool   // List the ManifestWorks of every consumer
+	Only         string // Show only ManifestWorks in this state (failing)
+	Filter       string // Filter by manifest content (kind, name, or kind/name)
+	Columns      string // Comma-separated column names for csv or table output
+	ColumnsSet   bool   // --columns was given, switching the table to one row per ManifestWork
+	// Label keys shown as extra columns (like kubectl get -L)
+	LabelColumns string
+	SortBy       string // name, age or status
+	Reverse      bool
+	// Show every condition type instead of only Applied/Available
+	ShowAllConditions bool
+	// Show the distinct manifest kinds of each ManifestWork
+	ShowKinds bool
 	// Global flags
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -58,15 +80,60 @@ Examples:
   maestro-cli list --consumer=cluster-west-1 --filter=Deployment/nginx
 
   # List with JSON output
-  maestro-cli list --consumer=cluster-west-1 --output=json`,
+  maestro-cli list --consumer=cluster-west-1 --output=json
+
+  # Export to CSV for a spreadsheet (default columns: name,consumer,applied,available,age)
+  maestro-cli list --consumer=cluster-west-1 --output=csv > works.csv
+  maestro-cli list --consumer=cluster-west-1 --output=csv --columns=name,version,manifests,updated
+
+  # The same columns as an aligned table, one row per ManifestWork
+  maestro-cli list --consumer=cluster-west-1 --columns=name,applied,available,age
+
+  # Show the region and env labels of each ManifestWork as extra columns
+  maestro-cli list --consumer=cluster-west-1 --label-columns=region,env
+
+  # Show failing ManifestWorks first, or the newest last
+  maestro-cli list --consumer=cluster-west-1 --sort-by=status
+  maestro-cli list --consumer=cluster-west-1 --sort-by=age --reverse
+
+  # Debug custom condition types: add a column for every condition type reported
+  maestro-cli list --consumer=cluster-west-1 --output=csv --show-all-conditions
+
+  # Find the works that contain a Job: show the kinds each one carries
+  maestro-cli list --consumer=cluster-west-1 --show-kinds --filter=Job/
+
+  # Triage: everything broken across the fleet
+  maestro-cli list --all-consumers --only=failing
+  maestro-cli list --all-consumers --only=failing --output=json`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, err := resolveOutput(cmd)
+			if err != nil {
+				return err
+			}
 			flags := &ListFlags{
-				Consumer: getStringFlag(cmd, "consumer"),
-				Filter:   getStringFlag(cmd, "filter"),
+				Consumer:     getStringFlag(cmd, "consumer"),
+				AllConsumers: getBoolFlag(cmd, "all-consumers"),
+				Only:         getStringFlag(cmd, "only"),
+				Filter:       getStringFlag(cmd, "filter"),
+				Columns:      getStringFlag(cmd, "columns"),
+				ColumnsSet:   cmd.Flags().Changed("columns"),
+				// Label columns
+				LabelColumns: getStringFlag(cmd, "label-columns"),
+				SortBy:       getStringFlag(cmd, "sort-by"),
+				Reverse:      getBoolFlag(cmd, "reverse"),
+				// Conditions
+				ShowAllConditions: getBoolFlag(cmd, "show-all-conditions"),
+				ShowKinds:         getBoolFlag(cmd, "show-kinds"),
 				// Global flags
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -75,7 +142,7 @@ Examples:
 				GRPCClientTokenFile: getStringFlag(cmd, "grpc-client-token-file"),
 				SourceID:            getStringFlag(cmd, "source-id"),
 				ResultsPath:         getStringFlag(cmd, "results-path"),
-				Output:              getStringFlag(cmd, "output"),
+				Output:              output,
 				Timeout:             getDurationFlag(cmd, "timeout"),
 				Verbose:             getBoolFlag(cmd, "verbose"),
 			}
@@ -85,19 +152,36 @@ Examples:
 	}
 
 	// Command-specific flags
-	cmd.Flags().String("consumer", "", "Target cluster name (required)")
+	cmd.Flags().String("consumer", "", "Target cluster name (required unless --all-consumers)")
+	cmd.Flags().Bool("all-consumers", false, "List the ManifestWorks of every consumer")
+	cmd.Flags().String("only", "", "Show only ManifestWorks in the given state: "+listOnlyFailing+
+		" (conditions reported, but not both Applied and Available)")
 	cmd.Flags().String(
 		"filter", "", "Filter by manifest content (e.g., 'nginx', 'Namespace/hyperfleet', 'Deployment/default/nginx')",
 	)
-
-	// Mark required flags
-	if err := cmd.MarkFlagRequired("consumer"); err != nil {
-		panic(err)
-	}
+	cmd.Flags().String("columns", defaultListColumns,
+		"Columns for csv output, or for the table as one row per ManifestWork (rejected with json and yaml): "+
+			strings.Join(listColumnNames(), ", "))
+	cmd.Flags().String("label-columns", "",
+		"Comma-separated label keys to show as extra columns (e.g., 'region,env')")
+	cmd.Flags().String("sort-by", maestro.SortByName,
+		"Sort by: "+strings.Join(maestro.SortKeys(), ", ")+" (age: newest first; status: failing first)")
+	cmd.Flags().Bool("reverse", false, "Reverse the sort order")
+	cmd.Flags().Bool("show-all-conditions", false,
+		"Show every condition type: one csv column per type, and reasons and messages in table output")
+	cmd.Flags().Bool("show-kinds", false,
+		"Show the distinct kinds of each ManifestWork's manifests (e.g., 'Job,ConfigMap') in table and csv output")
+
+	cmd.MarkFlagsOneRequired("consumer", "all-consumers")
+	cmd.MarkFlagsMutuallyExclusive("consumer", "all-consumers")
 
 	return cmd
 }
 
+// listOnlyFailing is the --only value keeping the ManifestWorks that are not
+// both applied and available
+const listOnlyFailing = "failing"
+
 // runListCommand executes the list command using HTTP API
 func runListCommand(ctx context.Context, flags *ListFlags) error {
 	// Set up context with timeout
@@ -113,10 +197,38 @@ func runListCommand(ctx context.Context, flags *ListFlags) error {
 		Format: "text",
 	})
 
+	// Validate the column selection before making any requests; JSON and YAML
+	// output have a fixed layout that --columns cannot change
+	columns, err := parseListColumns(flags.Columns)
+	if err != nil {
+		return err
+	}
+	if flags.ColumnsSet && (strings.EqualFold(flags.Output, "json") || strings.EqualFold(flags.Output, "yaml")) {
+		return fmt.Errorf("--columns only applies to table and %s output", outputFormatCSV)
+	}
+	if flags.ShowKinds && !hasListColumn(columns, "kinds") {
+		columns = append(columns, kindsColumn)
+	}
+	labelKeys := parseLabelColumns(flags.LabelColumns)
+	columns = append(columns, labelColumns(labelKeys)...)
+	if err := maestro.ValidateSortKey(flags.SortBy); err != nil {
+		return err
+	}
+	if flags.Only != "" && flags.Only != listOnlyFailing {
+		return fmt.Errorf("unsupported --only value %q (supported: %s)", flags.Only, listOnlyFailing)
+	}
+
 	// Create HTTP-only client (no gRPC subscription needed for list)
 	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
@@ -127,21 +239,17 @@ func runListCommand(ctx context.Context, flags *ListFlags) error {
 		}
 	}()
 
-	// Validate consumer exists
-	if err := client.ValidateConsumer(ctx, flags.Consumer); err != nil {
-		return err
-	}
-
 	// List ManifestWorks using HTTP API (reads directly from database)
 	log.Debug(ctx, "Listing ManifestWorks via HTTP API", logger.Fields{
 		"consumer":      flags.Consumer,
+		"all_consumers": flags.AllConsumers,
 		"http_endpoint": flags.HTTPEndpoint,
 		"filter":        flags.Filter,
 	})
 
-	works, err := client.ListManifestWorksHTTP(ctx, flags.Consumer)
+	works, err := listWorks(ctx, client, flags)
 	if err != nil {
-		return fmt.Errorf("failed to list ManifestWorks: %w", err)
+		return err
 	}
 
 	// Apply filter if specified
@@ -152,19 +260,85 @@ func runListCommand(ctx context.Context, flags *ListFlags) error {
 			"matched": len(works),
 		})
 	}
+	if flags.Only == listOnlyFailing {
+		works = filterFailing(works)
+	}
+	if err := maestro.SortResourceBundles(works, flags.SortBy, flags.Reverse); err != nil {
+		return err
+	}
 
-	// Output based on format
-	switch strings.ToLower(flags.Output) {
-	case "json":
-		return outputResourceBundlesJSON(works)
-	case "yaml":
-		return outputResourceBundlesYAML(works)
+	// Output based on format; a table with --columns lays out the same
+	// columns as CSV, one row per ManifestWork
+	format := strings.ToLower(flags.Output)
+	switch {
+	case format == "json":
+		return outputResourceBundlesJSON(withLabelColumns(works, labelKeys))
+	case format == "yaml":
+		return outputResourceBundlesYAML(withLabelColumns(works, labelKeys))
+	case format == outputFormatCSV || flags.ColumnsSet:
+		if flags.ShowAllConditions {
+			columns = append(columns, conditionColumns(works, columns)...)
+		}
+		now := time.Now()
+		if skewed := maestro.CountClockSkewed(works, now); skewed > 0 && hasListColumn(columns, "age") {
+			log.Warn(ctx, "Some ManifestWorks were created after the local time; check for clock skew. "+
+				"Their age is shown as 0s", logger.Fields{"count": skewed})
+		}
+		if format == outputFormatCSV {
+			return outputResourceBundlesCSV(works, columns, now)
+		}
+		return outputResourceBundlesColumns(os.Stdout, works, columns, now)
 	default:
-		outputResourceBundlesTable(works, flags.Consumer, flags.Filter)
+		outputResourceBundlesTable(works, flags, labelKeys)
 		return nil
 	}
 }
 
+// listWorks fetches the ManifestWorks of the consumer in flags, or of every
+// consumer with --all-consumers
+func listWorks(
+	ctx context.Context,
+	client *maestro.Client,
+	flags *ListFlags,
+) ([]maestro.ResourceBundleSummary, error) {
+	if !flags.AllConsumers {
+		if err := client.ValidateConsumer(ctx, flags.Consumer); err != nil {
+			return nil, err
+		}
+		works, err := client.ListManifestWorksHTTP(ctx, flags.Consumer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ManifestWorks: %w", err)
+		}
+		return works, nil
+	}
+
+	consumers, err := client.ListConsumers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumers: %w", err)
+	}
+	var works []maestro.ResourceBundleSummary
+	for _, consumer := range consumers {
+		consumerWorks, err := client.ListManifestWorksHTTP(ctx, consumer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ManifestWorks of consumer %s: %w", consumer, err)
+		}
+		works = append(works, consumerWorks...)
+	}
+	return works, nil
+}
+
+// filterFailing keeps the ManifestWorks that maestro.IsFailing reports, the
+// same ones the TUI marks as failing
+func filterFailing(items []maestro.ResourceBundleSummary) []maestro.ResourceBundleSummary {
+	failing := []maestro.ResourceBundleSummary{}
+	for _, rb := range items {
+		if maestro.IsFailing(rb.DeletedAt, rb.Conditions) {
+			failing = append(failing, rb)
+		}
+	}
+	return failing
+}
+
 // filterResourceBundles filters ResourceBundleSummary by manifest content
 // Supports patterns like:
 //   - "nginx"                     - matches any manifest containing "nginx" in name
@@ -238,17 +412,30 @@ func matchesResourceBundleFilter(
 	return false
 }
 
-// outputResourceBundlesTable outputs ResourceBundleSummary in table format with details
-func outputResourceBundlesTable(items []maestro.ResourceBundleSummary, consumer, filter string) {
+// outputResourceBundlesTable outputs ResourceBundleSummary in table format with details.
+// With --show-all-conditions, each condition also shows its reason and message;
+// with --show-kinds, each ManifestWork lists the kinds of its manifests;
+// with --all-consumers, each ManifestWork shows its consumer.
+func outputResourceBundlesTable(items []maestro.ResourceBundleSummary, flags *ListFlags, labelKeys []string) {
+	what := "ManifestWorks"
+	if flags.Only == listOnlyFailing {
+		what = "failing ManifestWorks"
+	}
+	scope := "for consumer " + flags.Consumer
+	if flags.AllConsumers {
+		scope = "on any consumer"
+	}
 	if len(items) == 0 {
-		if filter != "" {
-			fmt.Printf("No ManifestWorks matching '%s' found for consumer %s\n", filter, consumer)
+		if flags.Filter != "" {
+			fmt.Printf("No %s matching '%s' found %s\n", what, flags.Filter, scope)
 		} else {
-			fmt.Printf("No ManifestWorks found for consumer %s\n", consumer)
+			fmt.Printf("No %s found %s\n", what, scope)
 		}
 		return
 	}
 
+	consumers := map[string]bool{}
+
 	for i, rb := range items {
 		if i > 0 {
 			fmt.Println()
@@ -256,10 +443,20 @@ func outputResourceBundlesTable(items []maestro.ResourceBundleSummary, consumer,
 
 		// Print ManifestWork header
 		fmt.Printf("ManifestWork: %s\n", rb.Name)
+		if flags.AllConsumers {
+			fmt.Printf("  Consumer:  %s\n", rb.ConsumerName)
+			consumers[rb.ConsumerName] = true
+		}
 		fmt.Printf("  ID:        %s\n", rb.ID)
 		fmt.Printf("  Version:   %d\n", rb.Version)
 		fmt.Printf("  Created:   %s\n", rb.CreatedAt)
 		fmt.Printf("  Updated:   %s\n", rb.UpdatedAt)
+		if flags.ShowKinds {
+			fmt.Printf("  Kinds:     %s\n", strings.Join(maestro.ManifestKinds(rb.Manifests), ","))
+		}
+		for _, key := range labelKeys {
+			fmt.Printf("  %-10s %s\n", key+":", rb.Labels[key])
+		}
 
 		// Print manifests
 		fmt.Printf("  Manifests (%d):\n", rb.ManifestCount)
@@ -272,16 +469,29 @@ func outputResourceBundlesTable(items []maestro.ResourceBundleSummary, consumer,
 			fmt.Printf("  Conditions:\n")
 			for _, cond := range rb.Conditions {
 				fmt.Printf("    - %s: %s\n", cond.Type, cond.Status)
+				if !flags.ShowAllConditions {
+					continue
+				}
+				if cond.Reason != "" {
+					fmt.Printf("      Reason:  %s\n", cond.Reason)
+				}
+				if cond.Message != "" {
+					fmt.Printf("      Message: %s\n", cond.Message)
+				}
 			}
 		}
 	}
 
 	fmt.Printf("\n─────────────────────────────────────────\n")
-	fmt.Printf("Total: %d ManifestWork(s) for consumer %s\n", len(items), consumer)
+	if flags.AllConsumers {
+		fmt.Printf("Total: %d ManifestWork(s) on %d consumer(s)\n", len(items), len(consumers))
+	} else {
+		fmt.Printf("Total: %d ManifestWork(s) for consumer %s\n", len(items), flags.Consumer)
+	}
 }
 
 // outputResourceBundlesJSON outputs ResourceBundleSummary in JSON format
-func outputResourceBundlesJSON(items []maestro.ResourceBundleSummary) error {
+func outputResourceBundlesJSON(items interface{}) error {
 	data, err := json.MarshalIndent(items, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
@@ -290,8 +500,68 @@ func outputResourceBundlesJSON(items []maestro.ResourceBundleSummary) error {
 	return nil
 }
 
+// outputResourceBundlesCSV outputs ResourceBundleSummary as CSV with a header row
+func outputResourceBundlesCSV(items []maestro.ResourceBundleSummary, columns []listColumn, now time.Time) error {
+	w := csv.NewWriter(os.Stdout)
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.name
+	}
+	if err := w.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	for _, rb := range items {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			row[i] = col.value(rb, now)
+		}
+		if err := w.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// outputResourceBundlesColumns outputs ResourceBundleSummary as an aligned
+// table of the selected columns, one row per ManifestWork under an upper-case
+// header, like kubectl get
+func outputResourceBundlesColumns(
+	out io.Writer,
+	items []maestro.ResourceBundleSummary,
+	columns []listColumn,
+	now time.Time,
+) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = strings.ToUpper(col.name)
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, rb := range items {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			row[i] = col.value(rb, now)
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write table: %w", err)
+	}
+	return nil
+}
+
 // outputResourceBundlesYAML outputs ResourceBundleSummary in YAML format
-func outputResourceBundlesYAML(items []maestro.ResourceBundleSummary) error {
+func outputResourceBundlesYAML(items interface{}) error {
 	data, err := yaml.Marshal(items)
 	if err != nil {
 		return fmt.Errorf("failed to marshal YAML: %w", err)
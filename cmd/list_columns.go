@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+const (
+	// outputFormatCSV selects comma-separated output in the list command
+	outputFormatCSV = "csv"
+
+	// defaultListColumns is the column selection used when --columns is not given
+	defaultListColumns = "name,consumer,applied,available,age"
+)
+
+// listColumn is one selectable column of list output
+type listColumn struct {
+	name  string
+	value func(rb maestro.ResourceBundleSummary, now time.Time) string
+}
+
+// kindsColumn lists the distinct manifest kinds of a work, e.g. "Job,ConfigMap";
+// --show-kinds adds it when --columns does not select it
+var kindsColumn = listColumn{name: "kinds", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string {
+	return strings.Join(maestro.ManifestKinds(rb.Manifests), ",")
+}}
+
+// listColumns holds every column that can be selected with --columns, in help-text order
+var listColumns = []listColumn{
+	{name: "name", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string { return rb.Name }},
+	{name: "id", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string { return rb.ID }},
+	{name: "consumer", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string { return rb.ConsumerName }},
+	{name: "version", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string {
+		return strconv.Itoa(int(rb.Version))
+	}},
+	{name: "manifests", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string {
+		return strconv.Itoa(rb.ManifestCount)
+	}},
+	kindsColumn,
+	{name: "applied", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string {
+		return conditionStatus(rb.Conditions, "Applied")
+	}},
+	{name: "available", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string {
+		return conditionStatus(rb.Conditions, "Available")
+	}},
+	{name: "created", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string { return rb.CreatedAt }},
+	{name: "updated", value: func(rb maestro.ResourceBundleSummary, _ time.Time) string { return rb.UpdatedAt }},
+	{name: "age", value: func(rb maestro.ResourceBundleSummary, now time.Time) string {
+		return formatAge(rb.CreatedAt, now)
+	}},
+}
+
+// hasListColumn reports whether columns include the one called name
+func hasListColumn(columns []listColumn, name string) bool {
+	for _, col := range columns {
+		if col.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// listColumnNames returns the names accepted by --columns
+func listColumnNames() []string {
+	names := make([]string, 0, len(listColumns))
+	for _, col := range listColumns {
+		names = append(names, col.name)
+	}
+	return names
+}
+
+// parseListColumns resolves a comma-separated --columns value into columns
+func parseListColumns(spec string) ([]listColumn, error) {
+	if strings.TrimSpace(spec) == "" {
+		spec = defaultListColumns
+	}
+
+	var columns []listColumn
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, col := range listColumns {
+			if col.name == name {
+				columns = append(columns, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column %q (valid columns: %s)", name, strings.Join(listColumnNames(), ", "))
+		}
+	}
+	return columns, nil
+}
+
+// conditionStatus returns the status of the named condition, or "Unknown" if it is not reported
+func conditionStatus(conditions []maestro.ConditionSummary, condType string) string {
+	for _, c := range conditions {
+		if c.Type == condType {
+			return c.Status
+		}
+	}
+	return "Unknown"
+}
+
+// conditionColumns builds one column per condition type reported by any of
+// items, in the order first seen, skipping types already shown by selected
+// (e.g. the applied and available columns). A work that does not report a
+// type shows "Unknown".
+func conditionColumns(items []maestro.ResourceBundleSummary, selected []listColumn) []listColumn {
+	seen := make(map[string]bool, len(selected))
+	for _, col := range selected {
+		seen[strings.ToLower(col.name)] = true
+	}
+	var columns []listColumn
+	for _, rb := range items {
+		for _, c := range rb.Conditions {
+			if c.Type == "" || seen[strings.ToLower(c.Type)] {
+				continue
+			}
+			seen[strings.ToLower(c.Type)] = true
+			condType := c.Type
+			columns = append(columns, listColumn{
+				name: condType,
+				value: func(rb maestro.ResourceBundleSummary, _ time.Time) string {
+					return conditionStatus(rb.Conditions, condType)
+				},
+			})
+		}
+	}
+	return columns
+}
+
+// formatAge renders the time since an RFC3339 timestamp in kubectl style (e.g. 45s, 12m, 3h, 5d);
+// a timestamp ahead of now shows as 0s
+func formatAge(timestamp string, now time.Time) string {
+	age, _, err := maestro.TimestampAge(timestamp, now)
+	if err != nil {
+		return ""
+	}
+	return maestro.FormatAge(age)
+}
+
+// parseLabelColumns splits a comma-separated --label-columns value into label keys
+func parseLabelColumns(spec string) []string {
+	var keys []string
+	for _, key := range strings.Split(spec, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// labelColumns builds one column per label key; the cell is empty when the label is absent
+func labelColumns(keys []string) []listColumn {
+	columns := make([]listColumn, 0, len(keys))
+	for _, key := range keys {
+		columns = append(columns, listColumn{
+			name: key,
+			value: func(rb maestro.ResourceBundleSummary, _ time.Time) string {
+				return rb.Labels[key]
+			},
+		})
+	}
+	return columns
+}
+
+// resourceBundleWithLabelColumns adds the selected label columns to structured (json/yaml) output
+type resourceBundleWithLabelColumns struct {
+	maestro.ResourceBundleSummary
+	LabelColumns map[string]string `json:"labelColumns" yaml:"labelColumns"`
+}
+
+// withLabelColumns returns items unchanged when no label columns are selected, otherwise
+// wraps each item so every selected key is present (empty when the label is absent)
+func withLabelColumns(items []maestro.ResourceBundleSummary, keys []string) interface{} {
+	if len(keys) == 0 {
+		return items
+	}
+	out := make([]resourceBundleWithLabelColumns, 0, len(items))
+	for _, rb := range items {
+		cols := make(map[string]string, len(keys))
+		for _, key := range keys {
+			cols[key] = rb.Labels[key]
+		}
+		out = append(out, resourceBundleWithLabelColumns{ResourceBundleSummary: rb, LabelColumns: cols})
+	}
+	return out
+}
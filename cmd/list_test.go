@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+func TestOutputResourceBundlesColumns(t *testing.T) {
+	columns, err := parseListColumns("name,version")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	columns = append(columns, labelColumns([]string{"region"})...)
+	items := []maestro.ResourceBundleSummary{
+		{Name: "web", Version: 3, Labels: map[string]string{"region": "west"}},
+		{Name: "database-primary", Version: 12},
+	}
+
+	var out bytes.Buffer
+	if err := outputResourceBundlesColumns(&out, items, columns, time.Now()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "NAME               VERSION   REGION\n" +
+		"web                3         west\n" +
+		"database-primary   12        \n"
+	if out.String() != want {
+		t.Errorf("expected the selected columns aligned under a header, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Help groups of the root command
+const (
+	groupManifests = "manifests"
+	groupConsumers = "consumers"
+	groupOther     = "other"
+)
+
+// NewManifestsCommand creates the manifests command, which groups the commands
+// that act on ManifestWorks. Each subcommand is built by the same constructor
+// as its top-level counterpart, so flags and behavior are identical; the
+// top-level commands remain for compatibility.
+func NewManifestsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "manifests",
+		Aliases: []string{"manifestworks"},
+		Short:   "Manage ManifestWorks (list, get, describe, apply, diff, delete, wait, watch)",
+		Long: `Manage ManifestWorks on a target cluster.
+
+The same commands are also available at the top level, so
+"maestro-cli manifests list" and "maestro-cli list" are equivalent.
+
+Examples:
+  # List ManifestWorks for a consumer
+  maestro-cli manifests list --consumer=cluster-west-1
+
+  # Apply a ManifestWork and wait for it to become Available
+  maestro-cli manifests apply --manifest-file=nodepool.yaml --consumer=cluster-west-1 --wait
+
+  # Wait for a condition
+  maestro-cli manifests wait --name=hyperfleet-cluster-west-1-nodepool --consumer=cluster-west-1 --for=Applied`,
+		GroupID: groupManifests,
+	}
+
+	cmd.AddCommand(
+		NewListCommand(),
+		NewGetCommand(),
+		NewDescribeCommand(),
+		NewApplyCommand(),
+		NewDiffCommand(),
+		NewDeleteCommand(),
+		NewWaitCommand(),
+		NewWatchCommand(),
+	)
+
+	return cmd
+}
+
+// addCommandGroups sorts the root command's subcommands into help groups:
+// ManifestWork commands (the manifests group and its top-level aliases),
+// consumer commands and everything else.
+func addCommandGroups(root *cobra.Command) {
+	root.AddGroup(
+		&cobra.Group{ID: groupManifests, Title: "ManifestWork Commands:"},
+		&cobra.Group{ID: groupConsumers, Title: "Consumer Commands:"},
+		&cobra.Group{ID: groupOther, Title: "Other Commands:"},
+	)
+	manifestCommands := map[string]bool{}
+	for _, sub := range root.Commands() {
+		if sub.Name() == "manifests" {
+			for _, c := range sub.Commands() {
+				manifestCommands[c.Name()] = true
+			}
+		}
+	}
+	for _, sub := range root.Commands() {
+		switch {
+		case sub.GroupID != "":
+		case manifestCommands[sub.Name()], sub.Name() == "build", sub.Name() == "validate":
+			sub.GroupID = groupManifests
+		case sub.Name() == "consumers":
+			sub.GroupID = groupConsumers
+		default:
+			sub.GroupID = groupOther
+		}
+	}
+	root.SetHelpCommandGroupID(groupOther)
+	root.SetCompletionCommandGroupID(groupOther)
+}
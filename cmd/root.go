@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/config"
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 )
 
 const (
@@ -24,9 +29,23 @@ Environment Variables:
   MAESTRO_GRPC_TOKEN           Bearer token for authentication
   MAESTRO_GRPC_TOKEN_FILE      Path to file containing bearer token
   MAESTRO_SOURCE_ID            Source ID for CloudEvents subscription (default: maestro-cli)
+  MAESTRO_API_PREFIX           Path of the REST API under the HTTP endpoint (default: /api/maestro/v1)
+  MAESTRO_TLS_MIN_VERSION      Lowest TLS version accepted: 1.2 or 1.3
+  MAESTRO_CONFIG               Config files to merge, separated like PATH (see Config File)
 
 Note: Command-line flags take priority over environment variables.
 
+Config File:
+  ~/.config/maestro-cli/config.yaml (under $XDG_CONFIG_HOME when set) holds
+  defaults, e.g. "output: json" for get, list and describe. Flags override it.
+  MAESTRO_CONFIG=org.yaml:mine.yaml merges those files instead, later files
+  overriding keys set by earlier ones. The current context's endpoint applies
+  when neither --http-endpoint nor MAESTRO_HTTP_ENDPOINT is set; manage contexts
+  and defaults with "maestro-cli config".
+
+ManifestWork commands are grouped under "maestro-cli manifests" and also
+available at the top level, e.g. "maestro-cli manifests list" or "maestro-cli list".
+
 Examples:
   # Apply a ManifestWork to a target cluster
   maestro-cli apply --manifest-file=nodepool.yaml --consumer=cluster-west-1 --wait
@@ -64,6 +83,8 @@ const (
 	// This is an environment variable name, not a credential
 	EnvGRPCTokenFile = "MAESTRO_GRPC_TOKEN_FILE" //nolint:gosec
 	EnvSourceID      = "MAESTRO_SOURCE_ID"
+	EnvTLSMinVersion = "MAESTRO_TLS_MIN_VERSION"
+	EnvAPIPrefix     = "MAESTRO_API_PREFIX"
 )
 
 // Default values
@@ -87,6 +108,7 @@ func NewRootCommand() *cobra.Command {
 		CompletionOptions: cobra.CompletionOptions{
 			DisableDefaultCmd: true,
 		},
+		PersistentPreRunE: applyCurrentContext,
 	}
 
 	// Add global flags
@@ -106,7 +128,11 @@ func NewRootCommand() *cobra.Command {
 		NewBuildCommand(),
 		NewVersionCommand(),
 		NewTUICommand(),
+		NewConsumersCommand(),
+		NewManifestsCommand(),
+		NewConfigCommand(),
 	)
+	addCommandGroups(cmd)
 
 	return cmd
 }
@@ -118,6 +144,8 @@ func addGlobalFlags(cmd *cobra.Command) {
 		"Maestro gRPC server endpoint (env: MAESTRO_GRPC_ENDPOINT)")
 	cmd.PersistentFlags().String("http-endpoint", getEnvOrDefault(EnvHTTPEndpoint, DefaultHTTPEndpoint),
 		"Maestro HTTP server endpoint (env: MAESTRO_HTTP_ENDPOINT)")
+	cmd.PersistentFlags().String("api-prefix", getEnvOrDefault(EnvAPIPrefix, maestro.DefaultAPIPrefix),
+		"Path of the Maestro REST API under --http-endpoint (env: MAESTRO_API_PREFIX)")
 
 	// Global authentication flags
 	cmd.PersistentFlags().Bool("grpc-insecure", getEnvBool(EnvGRPCInsecure),
@@ -134,6 +162,20 @@ func addGlobalFlags(cmd *cobra.Command) {
 		"Bearer token for authentication (env: MAESTRO_GRPC_TOKEN)")
 	cmd.PersistentFlags().String("grpc-client-token-file", os.Getenv(EnvGRPCTokenFile),
 		"Path to file containing bearer token (env: MAESTRO_GRPC_TOKEN_FILE)")
+	cmd.PersistentFlags().String("tls-min-version", os.Getenv(EnvTLSMinVersion),
+		"Lowest TLS version accepted for HTTP and gRPC connections: 1.2 or 1.3 (default 1.2, env: MAESTRO_TLS_MIN_VERSION)")
+
+	// Global retry flags
+	cmd.PersistentFlags().Int("max-retries", maestro.DefaultMaxRetries,
+		"Retries of an HTTP read that fails with a connection error or a 429, 502, 503 or 504 response (0 disables)")
+	cmd.PersistentFlags().Duration("retry-backoff", maestro.DefaultRetryBackoff,
+		"Wait before the first retry; each further retry doubles it, up to 10s")
+
+	// Global impersonation flags
+	cmd.PersistentFlags().String("as", "",
+		"Username to impersonate on HTTP requests, like kubectl --as (the server must support impersonation)")
+	cmd.PersistentFlags().StringArray("as-group", nil,
+		"Group to impersonate on HTTP requests; repeat for several groups (requires --as)")
 
 	// Source ID for CloudEvents subscription
 	cmd.PersistentFlags().String("source-id", getEnvOrDefault(EnvSourceID, DefaultSourceID),
@@ -141,7 +183,8 @@ func addGlobalFlags(cmd *cobra.Command) {
 
 	// Global output flags
 	cmd.PersistentFlags().String("results-path", "", "Path to write command results for status-reporter integration")
-	cmd.PersistentFlags().String("output", "yaml", "Output format: yaml, json")
+	cmd.PersistentFlags().String("output", "yaml",
+		"Output format: yaml, json (get, list and describe default to the config file's output)")
 
 	// Global behavior flags
 	cmd.PersistentFlags().Duration("timeout", 0, "Maximum time to wait for operation completion")
@@ -178,3 +221,69 @@ func getDurationFlag(cmd *cobra.Command, name string) time.Duration {
 	value, _ := cmd.Flags().GetDuration(name)
 	return value
 }
+
+func getIntFlag(cmd *cobra.Command, name string) int {
+	value, _ := cmd.Flags().GetInt(name)
+	return value
+}
+
+func getStringArrayFlag(cmd *cobra.Command, name string) []string {
+	value, _ := cmd.Flags().GetStringArray(name)
+	return value
+}
+
+// applyCurrentContext makes the config file's current context supply
+// --http-endpoint when neither the flag nor MAESTRO_HTTP_ENDPOINT is set. The
+// config commands skip it, so a broken config file can still be repaired with
+// them.
+func applyCurrentContext(cmd *cobra.Command, _ []string) error {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Name() == "config" {
+			return nil
+		}
+	}
+	flag := cmd.Flags().Lookup("http-endpoint")
+	if flag == nil || flag.Changed || os.Getenv(EnvHTTPEndpoint) != "" {
+		return nil
+	}
+	cfg, err := config.LoadDefault()
+	if err != nil {
+		return err
+	}
+	if endpoint := cfg.CurrentEndpoint(); endpoint != "" {
+		return flag.Value.Set(endpoint)
+	}
+	return nil
+}
+
+// resolveOutput returns --output when it was given on the command line,
+// otherwise the config file's default output, otherwise the flag default.
+func resolveOutput(cmd *cobra.Command) (string, error) {
+	if cmd.Flags().Changed("output") {
+		return getStringFlag(cmd, "output"), nil
+	}
+	cfg, err := config.LoadDefault()
+	if err != nil {
+		return "", err
+	}
+	if cfg.Output != "" {
+		return cfg.Output, nil
+	}
+	return getStringFlag(cmd, "output"), nil
+}
+
+// marshalJSON encodes v for --output=json: indented by default, or on a single
+// line with compact, for tools that read one JSON document per line.
+func marshalJSON(v interface{}, compact bool) ([]byte, error) {
+	var data []byte
+	var err error
+	if compact {
+		data, err = json.Marshal(v)
+	} else {
+		data, err = json.MarshalIndent(v, "", "  ")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	return data, nil
+}
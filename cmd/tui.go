@@ -1,11 +1,19 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 
+	cliconfig "github.com/openshift-hyperfleet/maestro-cli/internal/config"
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/internal/tui"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
 // NewTUICommand creates the `tui` subcommand for maestro-cli.
@@ -14,7 +22,29 @@ func NewTUICommand() *cobra.Command {
 		Use:   "tui",
 		Short: "Launch interactive terminal UI",
 		Long: `Launch an interactive terminal UI to browse Maestro consumers and
-ManifestWorks, with live watch mode, filtering, create, and delete actions.`,
+ManifestWorks, with live watch mode, filtering, create, and delete actions.
+
+Use --demo to try it without a server: the TUI opens on built-in sample data,
+and creates and deletes only change that in-memory copy.
+
+The detail panel opens in the view matching the config file's output setting
+(json, yaml, or table for the formatted view). Endpoints listed under the
+config file's endpoints key are offered on the connect screen, each probed in
+the background and marked reachable or unreachable; pick one with the arrow
+keys.
+
+On terminals narrower than 100 columns, or with --layout=stacked, the main
+screen shows one panel at a time: Tab cycles full-screen through consumers,
+ManifestWorks and the detail, and Enter opens the selected consumer's
+ManifestWorks or the selected ManifestWork's detail. With a single consumer the
+split layout leaves out the consumers panel until it is focused, unless
+--always-show-consumers is set.
+
+Secret data and stringData values are shown as *** in the JSON and YAML views.
+With --show-secrets, pressing S in the detail panel reveals them decoded for
+that ManifestWork; each reveal is recorded in the event log (press L) and
+appended as a JSON line to the --audit-log file (by default audit.log next to
+the config file). Copies with y, M or Ctrl+Y redact them unless revealed.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			config := maestro.ClientConfig{
 				HTTPEndpoint:        getPersistentStringFlag(cmd, "http-endpoint"),
@@ -27,17 +57,146 @@ ManifestWorks, with live watch mode, filtering, create, and delete actions.`,
 				GRPCClientToken:     getPersistentStringFlag(cmd, "grpc-client-token"),
 				GRPCClientTokenFile: getPersistentStringFlag(cmd, "grpc-client-token-file"),
 				SourceID:            getPersistentStringFlag(cmd, "source-id"),
+				PageSize:            getIntFlag(cmd, "page-size"),
+				ImpersonateUser:     getPersistentStringFlag(cmd, "as"),
+				ImpersonateGroups:   getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getPersistentStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getPersistentStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
+			}
+			if err := maestro.ValidatePageSize(config.PageSize); err != nil {
+				return fmt.Errorf("invalid --page-size: %w", err)
+			}
+			if err := maestro.ValidateTLSMinVersion(config.TLSMinVersion); err != nil {
+				return fmt.Errorf("invalid --tls-min-version: %w", err)
+			}
+			if err := maestro.ValidateAPIPrefix(config.APIPrefix); err != nil {
+				return fmt.Errorf("invalid --api-prefix: %w", err)
+			}
+			if err := maestro.ValidateRetries(config.MaxRetries, config.RetryBackoff); err != nil {
+				return fmt.Errorf("invalid retry settings: %w", err)
+			}
+
+			if getIntFlag(cmd, "clipboard-warn-size") < 0 {
+				return fmt.Errorf("--clipboard-warn-size must not be negative, got %d", getIntFlag(cmd, "clipboard-warn-size"))
+			}
+
+			if d := getDurationFlag(cmd, "idle-refresh"); d != 0 && d < tui.MinIdleRefresh {
+				return fmt.Errorf("--idle-refresh must be 0 (off) or at least %s, got %s", tui.MinIdleRefresh, d)
+			}
+
+			theme := getStringFlag(cmd, "theme")
+			if !tui.IsTheme(theme) {
+				return fmt.Errorf("unknown --theme %q (available: %s)", theme, strings.Join(tui.ThemeNames(), ", "))
+			}
+
+			layout := getStringFlag(cmd, "layout")
+			if !slices.Contains(tui.LayoutNames(), layout) {
+				return fmt.Errorf("unknown --layout %q (available: %s)", layout, strings.Join(tui.LayoutNames(), ", "))
+			}
+
+			cfg, err := cliconfig.LoadDefault()
+			if err != nil {
+				return err
+			}
+
+			var fixtures *tui.Fixtures
+			switch {
+			case getStringFlag(cmd, "fixtures") != "":
+				fixtures, err = tui.LoadFixtures(getStringFlag(cmd, "fixtures"))
+			case getBoolFlag(cmd, "demo"):
+				fixtures, err = tui.DemoFixtures()
+			}
+			if err != nil {
+				return err
+			}
+
+			var auditLogger *logger.Logger
+			if getBoolFlag(cmd, "show-secrets") {
+				audit, err := openAuditLog(getStringFlag(cmd, "audit-log"))
+				if err != nil {
+					return err
+				}
+				defer audit.Close()
+				auditLogger = logger.New(logger.Config{Level: "warn", Format: "json", Writer: audit})
 			}
 
-			m := tui.New(config)
-			p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
-			_, err := p.Run()
+			m := tui.New(config, tui.Options{
+				StatusTimestamps:    getBoolFlag(cmd, "status-timestamps"),
+				Theme:               theme,
+				Fixtures:            fixtures,
+				DetailView:          cfg.Output,
+				ScrollToError:       getBoolFlag(cmd, "scroll-to-error"),
+				Endpoints:           cfg.Endpoints,
+				Layout:              layout,
+				AlwaysShowConsumers: getBoolFlag(cmd, "always-show-consumers"),
+				ClipboardWarnSize:   getIntFlag(cmd, "clipboard-warn-size"),
+				IdleRefresh:         getDurationFlag(cmd, "idle-refresh"),
+				ShowSecrets:         getBoolFlag(cmd, "show-secrets"),
+				AuditLogger:         auditLogger,
+			})
+			programOpts := []tea.ProgramOption{tea.WithAltScreen()}
+			if !getBoolFlag(cmd, "no-mouse") {
+				programOpts = append(programOpts, tea.WithMouseCellMotion())
+			}
+			p := tea.NewProgram(m, programOpts...)
+			_, err = p.Run()
 			return err
 		},
 	}
+
+	cmd.Flags().Bool("status-timestamps", false, "Prefix status messages with the time they were raised")
+	cmd.Flags().Bool("demo", false, "Browse built-in sample data instead of connecting to a Maestro server")
+	cmd.Flags().String("fixtures", "", "Browse consumers and ManifestWorks from a JSON fixtures file (implies --demo)")
+	if err := cmd.Flags().MarkHidden("fixtures"); err != nil {
+		panic(err)
+	}
+	cmd.Flags().Int("page-size", maestro.DefaultPageSize,
+		fmt.Sprintf("ManifestWorks or consumers fetched per list request (1-%d)", maestro.MaxPageSize))
+	cmd.Flags().Bool("no-mouse", false,
+		"Leave the mouse to the terminal so text can be selected natively (disables clicking and wheel scrolling)")
+	cmd.Flags().Bool("scroll-to-error", false,
+		"Open each ManifestWork's formatted detail at its first failing condition instead of the top")
+	cmd.Flags().String("layout", "auto",
+		"Main screen layout: "+strings.Join(tui.LayoutNames(), ", ")+
+			" (stacked shows one panel at a time; auto stacks on terminals narrower than 100 columns)")
+	cmd.Flags().Bool("always-show-consumers", false,
+		"Keep the consumers panel in the split layout when the server has a single consumer")
+	cmd.Flags().Int("clipboard-warn-size", tui.DefaultClipboardWarnSize,
+		"Warn when a copy to the clipboard is larger than this many bytes (0 disables the warning)")
+	cmd.Flags().Duration("idle-refresh", 0,
+		"Reload the consumer list and the shown ManifestWork list this often in the background, e.g. 5m (0 disables)")
+	cmd.Flags().Bool("show-secrets", false,
+		"Allow revealing decoded Secret values in the detail panel with S (redacted as *** otherwise)")
+	cmd.Flags().String("audit-log", "",
+		"File each --show-secrets reveal is appended to (default audit.log next to the config file)")
+	cmd.Flags().String("theme", "auto", "Color theme: "+strings.Join(tui.ThemeNames(), ", ")+" (press t to cycle)")
+
 	return cmd
 }
 
+// openAuditLog opens the file Secret reveals are appended to, path or
+// audit.log in the config file's directory, creating it readable only by the
+// user.
+func openAuditLog(path string) (*os.File, error) {
+	if path == "" {
+		config := cliconfig.DefaultPath()
+		if config == "" {
+			return nil, fmt.Errorf("--show-secrets needs --audit-log: no config directory to keep audit.log in")
+		}
+		path = filepath.Join(filepath.Dir(config), "audit.log")
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create the audit log directory: %w", err)
+		}
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the audit log: %w", err)
+	}
+	return f, nil
+}
+
 // getPersistentStringFlag reads a string flag from the command or its parents.
 func getPersistentStringFlag(cmd *cobra.Command, name string) string {
 	val, _ := cmd.Flags().GetString(name)
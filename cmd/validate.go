@@ -111,55 +111,7 @@ func runValidateCommand(ctx context.Context, flags *ValidateFlags) error {
 	}
 
 	// Validate required fields
-	var errors []string
-
-	// Check metadata.name
-	if mw.Name == "" {
-		errors = append(errors, "metadata.name is required")
-	}
-
-	// Check manifests
-	if len(mw.Spec.Workload.Manifests) == 0 {
-		errors = append(errors, "spec.workload.manifests cannot be empty")
-	}
-
-	// Check top-level type metadata
-	if mw.APIVersion == "" {
-		errors = append(errors, "apiVersion is required")
-	}
-	if mw.Kind == "" {
-		errors = append(errors, "kind is required")
-	}
-
-	// Validate each manifest
-	for i, manifest := range mw.Spec.Workload.Manifests {
-		manifestData := manifest.Raw
-		if len(manifestData) == 0 {
-			errors = append(errors, fmt.Sprintf("manifest[%d] is empty", i))
-			continue
-		}
-
-		// Try to extract basic fields
-		var m map[string]interface{}
-		if err := manifestwork.UnmarshalManifest(manifestData, &m); err != nil {
-			errors = append(errors, fmt.Sprintf("manifest[%d] is not valid YAML/JSON: %v", i, err))
-			continue
-		}
-
-		if _, ok := m["apiVersion"]; !ok {
-			errors = append(errors, fmt.Sprintf("manifest[%d] missing apiVersion", i))
-		}
-		if _, ok := m["kind"]; !ok {
-			errors = append(errors, fmt.Sprintf("manifest[%d] missing kind", i))
-		}
-		if metadata, ok := m["metadata"].(map[string]interface{}); ok {
-			if _, ok := metadata["name"]; !ok {
-				errors = append(errors, fmt.Sprintf("manifest[%d] missing metadata.name", i))
-			}
-		} else {
-			errors = append(errors, fmt.Sprintf("manifest[%d] missing metadata", i))
-		}
-	}
+	errors := manifestwork.ValidateManifestWork(mw)
 
 	if len(errors) > 0 {
 		fmt.Println("Validation FAILED:")
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -15,7 +17,8 @@ import (
 )
 
 const (
-	statusWaiting = "Waiting"
+	statusWaiting         = "Waiting"
+	statusConditionNotMet = "ConditionNotMet"
 )
 
 // WaitFlags contains flags for the wait command
@@ -23,10 +26,29 @@ type WaitFlags struct {
 	Name     string
 	Consumer string
 	For      string // Condition to wait for (like kubectl --for)
+	Bell     bool   // Ring the terminal bell when the wait finishes
+	Explain  bool   // Describe the unmet conditions when the wait ends without the condition met
+	// StateFile persists progress so a restarted wait resumes against the original deadline
+	StateFile string
+	// WaitForCreation polls until a missing ManifestWork is created instead of
+	// failing at once
+	WaitForCreation bool
+	// FailIfEmpty fails at once on a missing ManifestWork, overriding WaitForCreation
+	FailIfEmpty bool
+	// Progress adds CI annotations for condition changes and the outcome (see manifestwork.ProgressFormats)
+	Progress string
+	// Strict fails a --timeout no longer than the poll interval instead of warning
+	Strict bool
 	// Global flags
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -53,22 +75,64 @@ Examples:
   # Wait for Job completion (like kubectl wait --for=condition=Complete)
   maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for="Job:Complete"
 
+  # Mix conditions with a JSONPath predicate on the resource bundle (as shown by get -o json)
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
+    --for='Applied AND {.status.resourceStatus[0].statusFeedback.values[0].fieldValue.integer}>=3'
+
   # Wait with timeout (default 5m if not specified)
   maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
     --for="Job:Complete OR Job:Failed" --timeout=10m
 
+  # Print how long the rollout took, e.g. {"name": ..., "durationSeconds": 42.318}
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for="Job:Complete" -o json
+
   # Wait and write results for status-reporter
   maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
-    --for=Available --results-path=/tmp/wait-results.json`,
+    --for=Available --results-path=/tmp/wait-results.json
+
+  # Wait for a ManifestWork that another pipeline step has yet to create
+  # (without --wait-for-creation, or with --fail-if-empty, a missing one fails at once)
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for="Job:Complete" \
+    --wait-for-creation --timeout=15m
+
+  # Ring the terminal bell when the wait finishes (condition met, timed out or failed)
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for="Job:Complete" --bell
+
+  # Survive CI retries: a rerun with the same state file only waits for what is left of the 30m
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for="Job:Complete" \
+    --timeout=30m --state-file=/tmp/wait-state.json
+
+  # On timeout, show which conditions were True, False or absent and their last messages
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 \
+    --for="Available AND Job:Complete" --timeout=2m --explain
+
+  # In GitHub Actions, annotate the run with condition changes and the outcome
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --for="Job:Complete" --progress=github
+
+  # Fail at once on a timeout too short to poll more than once, instead of warning
+  maestro-cli wait --name=hyperfleet-cluster-west-1-job --consumer=agent1 --timeout=500ms --strict`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			flags := &WaitFlags{
-				Name:     getStringFlag(cmd, "name"),
-				Consumer: getStringFlag(cmd, "consumer"),
-				For:      getStringFlag(cmd, "for"),
+				Name:            getStringFlag(cmd, "name"),
+				Consumer:        getStringFlag(cmd, "consumer"),
+				For:             getStringFlag(cmd, "for"),
+				Bell:            getBoolFlag(cmd, "bell"),
+				Explain:         getBoolFlag(cmd, "explain"),
+				StateFile:       getStringFlag(cmd, "state-file"),
+				WaitForCreation: getBoolFlag(cmd, "wait-for-creation"),
+				FailIfEmpty:     getBoolFlag(cmd, "fail-if-empty"),
+				Progress:        getStringFlag(cmd, "progress"),
+				Strict:          getBoolFlag(cmd, "strict"),
 				// Global flags
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -91,7 +155,41 @@ Examples:
 	cmd.Flags().String(
 		"for",
 		"Available",
-		"Condition to wait for (e.g., 'Available', 'Job:Complete', 'Job:Complete OR Job:Failed')",
+		"Condition to wait for (e.g., 'Available', 'Job:Complete', 'Job:Complete OR Job:Failed', "+
+			"'Available AND {.status.resourceStatus[0].conditions[0].status}=True')",
+	)
+	cmd.Flags().Bool("bell", false,
+		"Ring the terminal bell when the wait finishes: condition met, timed out or failed")
+	cmd.Flags().Bool(
+		"explain",
+		false,
+		"On timeout, describe each condition in --for: whether it was met, its status (or absence) and last message",
+	)
+	cmd.Flags().String(
+		"state-file",
+		"",
+		"Save wait progress to this file; a wait restarted with the same file counts earlier waiting against --timeout",
+	)
+	cmd.Flags().Bool(
+		"fail-if-empty",
+		false,
+		"Exit non-zero at once when the ManifestWork does not exist yet (the default without --wait-for-creation)",
+	)
+	cmd.Flags().Bool(
+		"wait-for-creation",
+		false,
+		"Keep polling until a ManifestWork that does not exist yet is created, within --timeout",
+	)
+	cmd.MarkFlagsMutuallyExclusive("fail-if-empty", "wait-for-creation")
+	cmd.Flags().Bool(
+		"strict",
+		false,
+		"Fail instead of warning when --timeout is too short to poll the ManifestWork's status more than once",
+	)
+	cmd.Flags().String(
+		"progress",
+		manifestwork.ProgressLog,
+		"Progress output: log, or github to also print GitHub Actions ::notice::/::error:: annotations on stdout",
 	)
 
 	// Mark required flags
@@ -105,8 +203,30 @@ Examples:
 	return cmd
 }
 
+// waitResult is what a successful wait prints with --output=json
+type waitResult struct {
+	Name     string `json:"name"`
+	Consumer string `json:"consumer"`
+	For      string `json:"for"`
+	// DurationSeconds is the time from invocation until the condition was met
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
 // runWaitCommand executes the wait command
-func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
+func runWaitCommand(ctx context.Context, flags *WaitFlags) (err error) {
+	start := time.Now()
+	if err := maestro.ValidateConditionExpression(flags.For); err != nil {
+		return fmt.Errorf("invalid --for: %w", err)
+	}
+	reporter, err := manifestwork.NewWaitReporter(flags.Progress, os.Stdout, flags.Name, flags.Consumer, flags.For)
+	if err != nil {
+		return fmt.Errorf("invalid --progress: %w", err)
+	}
+	if reporter != nil {
+		// Also covers failures before polling starts, such as a ManifestWork never created
+		defer func() { reporter.Finished(time.Since(start), err) }()
+	}
+
 	// Initialize logger
 	log := logger.New(logger.Config{
 		Level:     getLogLevel(flags.Verbose),
@@ -114,11 +234,26 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 		Component: "maestro-cli",
 		Version:   "dev",
 	})
+	if err := checkWaitTimeout(ctx, log, flags.Timeout, flags.Strict); err != nil {
+		return err
+	}
+	if flags.Bell {
+		// Rings however the wait ends once the flags are valid, on stderr so it
+		// never ends up in captured output
+		defer fmt.Fprint(os.Stderr, "\a")
+	}
 
 	// Create HTTP-only client (no gRPC needed for wait)
 	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
@@ -134,21 +269,25 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 		return err
 	}
 
-	// Check if ManifestWork exists
-	_, err = client.GetManifestWorkByNameHTTP(ctx, flags.Consumer, flags.Name)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			return fmt.Errorf("ManifestWork %q not found in consumer %q", flags.Name, flags.Consumer)
-		}
-		return fmt.Errorf("failed to check ManifestWork existence: %w", err)
-	}
-
 	// Use timeout if specified, otherwise default to 5 minutes
 	timeout := flags.Timeout
 	if timeout == 0 {
 		timeout = DefaultWaitTimeout
 	}
 
+	// Resume from an earlier run's state: only what is left of the timeout remains
+	var progress *waitProgress
+	if flags.StateFile != "" {
+		progress = newWaitProgress(ctx, log, flags, timeout)
+		if progress.prior >= timeout {
+			return fmt.Errorf(
+				"error waiting for condition '%s': earlier runs recorded in %s already waited %s of the %s timeout",
+				flags.For, flags.StateFile, progress.prior.Round(time.Second), timeout,
+			)
+		}
+		timeout -= progress.prior
+	}
+
 	log.Info(ctx, "Waiting for condition", logger.Fields{
 		"name":     flags.Name,
 		"consumer": flags.Consumer,
@@ -160,9 +299,18 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 	waitCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	// Waiting for creation uses up the same timeout as waiting for the condition
+	if err := awaitManifestWork(waitCtx, client, flags, log); err != nil {
+		if progress != nil {
+			progress.finish(false)
+		}
+		return err
+	}
+
 	// Create callback to update results file on each poll
 	var callback maestro.WaitCallback
-	if flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != "" {
+	writeResults := flags.ResultsPath != "" || os.Getenv("RESULTS_PATH") != ""
+	if writeResults {
 		callback = func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
 			status := statusWaiting
 			message := fmt.Sprintf("Waiting for condition '%s'", flags.For)
@@ -171,12 +319,21 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 				message = fmt.Sprintf("Condition '%s' met", flags.For)
 			}
 			result := manifestwork.BuildStatusResult(flags.Name, flags.Consumer, status, message, details)
+			if conditionMet {
+				result.DurationSeconds = durationSeconds(time.Since(start))
+			}
 			return manifestwork.WriteResult(flags.ResultsPath, result)
 		}
 	}
+	if progress != nil {
+		callback = progress.callback(callback)
+	}
+	if reporter != nil {
+		callback = manifestwork.ReportWaitProgress(reporter, callback)
+	}
 
 	// Wait for condition (poll every 1 second by default)
-	if err := client.WaitForCondition(
+	err = client.WaitForCondition(
 		waitCtx,
 		flags.Consumer,
 		flags.Name,
@@ -184,15 +341,196 @@ func runWaitCommand(ctx context.Context, flags *WaitFlags) error {
 		maestro.DefaultPollInterval,
 		log,
 		callback,
-	); err != nil {
+	)
+	if progress != nil {
+		progress.finish(err == nil)
+	}
+	var notMet *maestro.ConditionNotMetError
+	if flags.Explain && stderrors.As(err, &notMet) {
+		explanation := maestro.ExplainCondition(ctx, notMet.Details, flags.For, log)
+		if writeResults {
+			result := manifestwork.BuildStatusResult(flags.Name, flags.Consumer, statusConditionNotMet,
+				fmt.Sprintf("Condition '%s' not met: %s", flags.For, strings.Join(explanation, "; ")), notMet.Details)
+			if err := manifestwork.WriteResult(flags.ResultsPath, result); err != nil {
+				log.Warn(ctx, "Failed to write results", logger.Fields{"error": err.Error()})
+			}
+		}
+		return fmt.Errorf("error waiting for condition '%s': %w\n  %s",
+			flags.For, err, strings.Join(explanation, "\n  "))
+	}
+	if err != nil {
 		return fmt.Errorf("error waiting for condition '%s': %w", flags.For, err)
 	}
 
+	elapsed := time.Since(start)
 	log.Info(ctx, "Condition met", logger.Fields{
 		"name":     flags.Name,
 		"consumer": flags.Consumer,
 		"for":      flags.For,
+		"duration": elapsed.Round(time.Millisecond).String(),
 	})
 
+	if strings.EqualFold(flags.Output, defaultOutputFormatJSON) {
+		data, err := marshalJSON(waitResult{
+			Name:            flags.Name,
+			Consumer:        flags.Consumer,
+			For:             flags.For,
+			DurationSeconds: durationSeconds(elapsed),
+		}, false)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	}
+
+	return nil
+}
+
+// checkWaitTimeout rejects, with strict, or warns about a --timeout no longer
+// than the poll interval: such a wait gets the initial status check and can
+// time out before the first re-poll, so it mostly reports a timeout rather
+// than the rollout. Zero is the default timeout and always passes.
+func checkWaitTimeout(ctx context.Context, log *logger.Logger, timeout time.Duration, strict bool) error {
+	if timeout == 0 || timeout > maestro.DefaultPollInterval {
+		return nil
+	}
+	suggested := 10 * maestro.DefaultPollInterval
+	if strict {
+		return fmt.Errorf("--timeout=%s is not longer than the %s poll interval, so the status may never be polled again; "+
+			"use a timeout covering several polls, e.g. --timeout=%s", timeout, maestro.DefaultPollInterval, suggested)
+	}
+	log.Warn(ctx, "Timeout is not longer than the poll interval; the wait may end before a re-poll", logger.Fields{
+		"timeout":           timeout.String(),
+		"poll_interval":     maestro.DefaultPollInterval.String(),
+		"suggested_timeout": suggested.String(),
+	})
 	return nil
 }
+
+// awaitManifestWork checks that the ManifestWork to wait on exists. A missing
+// one fails at once, unless --wait-for-creation polls until it is created or
+// ctx ends.
+func awaitManifestWork(ctx context.Context, client *maestro.Client, flags *WaitFlags, log *logger.Logger) error {
+	ticker := time.NewTicker(maestro.DefaultPollInterval)
+	defer ticker.Stop()
+	logged := false
+	for {
+		_, err := client.GetManifestWorkByNameHTTP(ctx, flags.Consumer, flags.Name)
+		switch {
+		case err == nil:
+			return nil
+		case ctx.Err() != nil:
+			// Checked first, as a request cut off by the timeout fails with a non-NotFound error
+		case !errors.IsNotFound(err):
+			return fmt.Errorf("failed to check ManifestWork existence: %w", err)
+		case !flags.WaitForCreation || flags.FailIfEmpty:
+			return fmt.Errorf("ManifestWork %q not found in consumer %q (use --wait-for-creation to wait until it is created)",
+				flags.Name, flags.Consumer)
+		case !logged:
+			log.Info(ctx, "Waiting for ManifestWork to be created", logger.Fields{
+				"name":     flags.Name,
+				"consumer": flags.Consumer,
+			})
+			logged = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("error waiting for condition '%s': ManifestWork %q was not created in consumer %q: %w",
+				flags.For, flags.Name, flags.Consumer, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// durationSeconds renders d as seconds with millisecond precision
+func durationSeconds(d time.Duration) float64 {
+	return d.Round(time.Millisecond).Seconds()
+}
+
+// waitStateSaveInterval is how often --state-file is rewritten while polling
+const waitStateSaveInterval = 5 * time.Second
+
+// waitProgress tracks a wait's progress in its --state-file
+type waitProgress struct {
+	ctx       context.Context
+	log       *logger.Logger
+	path      string
+	state     *manifestwork.WaitState
+	prior     time.Duration // waited by earlier runs
+	runStart  time.Time
+	lastSaved time.Time
+}
+
+// newWaitProgress loads the state file, starting fresh when it is missing,
+// unreadable or from a different wait.
+func newWaitProgress(ctx context.Context, log *logger.Logger, flags *WaitFlags, timeout time.Duration) *waitProgress {
+	p := &waitProgress{ctx: ctx, log: log, path: flags.StateFile, runStart: time.Now()}
+	state, err := manifestwork.LoadWaitState(flags.StateFile)
+	switch {
+	case err != nil:
+		log.Warn(ctx, "Ignoring unreadable wait state file, starting fresh", logger.Fields{"error": err.Error()})
+	case state != nil && !state.Matches(flags.Name, flags.Consumer, flags.For):
+		log.Warn(ctx, "Wait state file is for a different wait, starting fresh", logger.Fields{
+			"state_file": flags.StateFile,
+			"name":       state.Name,
+			"consumer":   state.Consumer,
+			"for":        state.For,
+		})
+	case state != nil:
+		p.state = state
+		p.prior = time.Duration(state.Elapsed)
+		log.Info(ctx, "Resuming wait", logger.Fields{
+			"state_file": flags.StateFile,
+			"elapsed":    p.prior.Round(time.Second).String(),
+		})
+	}
+	if p.state == nil {
+		p.state = &manifestwork.WaitState{
+			Version:   manifestwork.WaitStateVersion,
+			Name:      flags.Name,
+			Consumer:  flags.Consumer,
+			For:       flags.For,
+			StartedAt: p.runStart,
+		}
+	}
+	p.state.Timeout = manifestwork.Duration(timeout)
+	return p
+}
+
+// callback wraps next so each poll also records progress
+func (p *waitProgress) callback(next maestro.WaitCallback) maestro.WaitCallback {
+	return func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
+		p.state.Conditions = details.Conditions
+		p.state.ConditionMet = conditionMet
+		if time.Since(p.lastSaved) >= waitStateSaveInterval {
+			p.save()
+		}
+		if next != nil {
+			return next(details, conditionMet)
+		}
+		return nil
+	}
+}
+
+// finish removes the state file once the condition is met, so the next wait
+// starts fresh, and otherwise saves the final elapsed time.
+func (p *waitProgress) finish(met bool) {
+	if met {
+		if err := os.Remove(p.path); err != nil && !stderrors.Is(err, os.ErrNotExist) {
+			p.log.Warn(p.ctx, "Failed to remove wait state file", logger.Fields{"error": err.Error()})
+		}
+		return
+	}
+	p.save()
+}
+
+func (p *waitProgress) save() {
+	now := time.Now()
+	p.lastSaved = now
+	p.state.Elapsed = manifestwork.Duration(p.prior + now.Sub(p.runStart))
+	p.state.UpdatedAt = now
+	if err := manifestwork.SaveWaitState(p.path, p.state); err != nil {
+		p.log.Warn(p.ctx, "Failed to save wait state", logger.Fields{"error": err.Error()})
+	}
+}
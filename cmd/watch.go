@@ -2,10 +2,17 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
@@ -16,10 +23,17 @@ type WatchFlags struct {
 	Name         string
 	Consumer     string
 	PollInterval time.Duration
+	WatchOutput  string // status, full or diff
 	// Global flags
 	GRPCEndpoint        string
 	HTTPEndpoint        string
 	GRPCInsecure        bool
+	As                  string        // User to impersonate on HTTP requests
+	AsGroups            []string      // Groups to impersonate, with As
+	TLSMinVersion       string        // Lowest TLS version accepted: 1.2 or 1.3
+	APIPrefix           string        // Path of the Maestro REST API, replacing /api/maestro/v1
+	MaxRetries          int           // Retries of a failed idempotent HTTP request
+	RetryBackoff        time.Duration // Wait before the first retry, doubling after
 	GRPCServerCAFile    string
 	GRPCClientCertFile  string
 	GRPCClientKeyFile   string
@@ -47,16 +61,26 @@ Examples:
   maestro-cli watch --name=hyperfleet-cluster-west-1-job --consumer=agent1 --poll-interval=5s
 
   # Watch with timeout
-  maestro-cli watch --name=hyperfleet-cluster-west-1-job --consumer=agent1 --timeout=10m`,
+  maestro-cli watch --name=hyperfleet-cluster-west-1-job --consumer=agent1 --timeout=10m
+
+  # Print only what changed between polls, as a unified diff of the YAML
+  maestro-cli watch --name=hyperfleet-cluster-west-1-job --consumer=agent1 --watch-output=diff`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			flags := &WatchFlags{
 				Name:         getStringFlag(cmd, "name"),
 				Consumer:     getStringFlag(cmd, "consumer"),
 				PollInterval: getDurationFlag(cmd, "poll-interval"),
+				WatchOutput:  getStringFlag(cmd, "watch-output"),
 				// Global flags
 				GRPCEndpoint:        getStringFlag(cmd, "grpc-endpoint"),
 				HTTPEndpoint:        getStringFlag(cmd, "http-endpoint"),
 				GRPCInsecure:        getBoolFlag(cmd, "grpc-insecure"),
+				As:                  getStringFlag(cmd, "as"),
+				AsGroups:            getStringArrayFlag(cmd, "as-group"),
+				TLSMinVersion:       getStringFlag(cmd, "tls-min-version"),
+				APIPrefix:           getStringFlag(cmd, "api-prefix"),
+				MaxRetries:          getIntFlag(cmd, "max-retries"),
+				RetryBackoff:        getDurationFlag(cmd, "retry-backoff"),
 				GRPCServerCAFile:    getStringFlag(cmd, "grpc-server-ca-file"),
 				GRPCClientCertFile:  getStringFlag(cmd, "grpc-client-cert-file"),
 				GRPCClientKeyFile:   getStringFlag(cmd, "grpc-client-key-file"),
@@ -77,6 +101,9 @@ Examples:
 	cmd.Flags().String("name", "", "ManifestWork name (required)")
 	cmd.Flags().String("consumer", "", "Target cluster name (required)")
 	cmd.Flags().Duration("poll-interval", maestro.DefaultPollInterval, "Interval between status checks")
+	cmd.Flags().String("watch-output", watchOutputStatus,
+		"What to print on each change: status (one status line), full (the whole object in --output format), "+
+			"or diff (a unified diff against the previous poll)")
 
 	// Mark required flags
 	if err := cmd.MarkFlagRequired("name"); err != nil {
@@ -89,15 +116,50 @@ Examples:
 	return cmd
 }
 
+// Values of --watch-output
+const (
+	watchOutputStatus = "status"
+	watchOutputFull   = "full"
+	watchOutputDiff   = "diff"
+)
+
+// validateWatchOutput checks a --watch-output value
+func validateWatchOutput(value string) error {
+	switch value {
+	case watchOutputStatus, watchOutputFull, watchOutputDiff:
+		return nil
+	}
+	return fmt.Errorf("unknown --watch-output %q (valid: %s, %s, %s)",
+		value, watchOutputStatus, watchOutputFull, watchOutputDiff)
+}
+
+// watchState is what the previous poll printed, used to detect changes
+type watchState struct {
+	version    int32
+	conditions string
+	rendered   string // full object, for --watch-output=full and diff
+}
+
 // runWatchCommand executes the watch command
 func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
+	if err := validateWatchOutput(flags.WatchOutput); err != nil {
+		return err
+	}
+
 	// Initialize logger
 	log := logger.New(logger.Config{Level: getLogLevel(flags.Verbose), Format: "text"})
 
 	// Create HTTP-only client
 	client, err := maestro.NewHTTPClient(maestro.ClientConfig{
-		HTTPEndpoint: flags.HTTPEndpoint,
-		GRPCInsecure: flags.GRPCInsecure,
+		HTTPEndpoint:      flags.HTTPEndpoint,
+		GRPCInsecure:      flags.GRPCInsecure,
+		ImpersonateUser:   flags.As,
+		ImpersonateGroups: flags.AsGroups,
+		TLSMinVersion:     flags.TLSMinVersion,
+		APIPrefix:         flags.APIPrefix,
+		MaxRetries:        flags.MaxRetries,
+		RetryBackoff:      flags.RetryBackoff,
+		Logger:            log,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create Maestro client: %w", err)
@@ -128,8 +190,7 @@ func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
 	})
 
 	// Track previous state to detect changes
-	var lastVersion int32
-	var lastConditions string
+	last := &watchState{}
 
 	// Exponential backoff for API failures
 	consecutiveFailures := 0
@@ -140,7 +201,7 @@ func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
 	defer ticker.Stop()
 
 	// Initial check
-	if err := printWatchStatus(watchCtx, client, flags, &lastVersion, &lastConditions); err != nil {
+	if err := printWatchStatus(watchCtx, client, flags, last); err != nil {
 		log.Warn(ctx, "Initial status check failed", logger.Fields{"error": err.Error()})
 		consecutiveFailures++
 		updateBackoffInterval(&currentInterval, &consecutiveFailures, baseInterval, ticker)
@@ -152,7 +213,7 @@ func runWatchCommand(ctx context.Context, flags *WatchFlags) error {
 			fmt.Println("\nWatch stopped")
 			return nil
 		case <-ticker.C:
-			if err := printWatchStatus(watchCtx, client, flags, &lastVersion, &lastConditions); err != nil {
+			if err := printWatchStatus(watchCtx, client, flags, last); err != nil {
 				log.Warn(ctx, "Status check failed", logger.Fields{"error": err.Error()})
 				consecutiveFailures++
 				updateBackoffInterval(&currentInterval, &consecutiveFailures, baseInterval, ticker)
@@ -211,13 +272,15 @@ func printWatchStatus(
 	ctx context.Context,
 	client *maestro.Client,
 	flags *WatchFlags,
-	lastVersion *int32,
-	lastConditions *string,
+	last *watchState,
 ) error {
 	details, err := client.GetManifestWorkDetailsHTTP(ctx, flags.Consumer, flags.Name)
 	if err != nil {
 		return err
 	}
+	if flags.WatchOutput != watchOutputStatus {
+		return printWatchObject(os.Stdout, details, flags, last)
+	}
 
 	// Build current conditions string
 	var condStr string
@@ -231,15 +294,15 @@ func printWatchStatus(
 	// Check for resource-level condition changes
 	for _, rs := range details.ResourceStatus {
 		for _, c := range rs.Conditions {
-			key := fmt.Sprintf("%s/%s:%s=%s", rs.Kind, rs.Name, c.Type, c.Status)
+			key := fmt.Sprintf("%s:%s=%s", rs.DisplayName(), c.Type, c.Status)
 			condStr += " " + key
 		}
 	}
 
 	// Only print if changed
-	if details.Version != *lastVersion || condStr != *lastConditions {
-		*lastVersion = details.Version
-		*lastConditions = condStr
+	if details.Version != last.version || condStr != last.conditions {
+		last.version = details.Version
+		last.conditions = condStr
 
 		// Print timestamp and status
 		fmt.Printf("[%s] v%d ", time.Now().Format("15:04:05"), details.Version)
@@ -255,7 +318,7 @@ func printWatchStatus(
 
 		// Print resource conditions
 		for _, rs := range details.ResourceStatus {
-			fmt.Printf("| %s/%s: ", rs.Kind, rs.Name)
+			fmt.Printf("| %s: ", rs.DisplayName())
 			for _, c := range rs.Conditions {
 				status := "✓"
 				if c.Status != "True" {
@@ -269,3 +332,85 @@ func printWatchStatus(
 
 	return nil
 }
+
+// printWatchObject writes the whole ManifestWork (--watch-output=full) or a
+// diff against the previous poll (--watch-output=diff) to w when anything
+// changed. The first poll always prints the whole object.
+func printWatchObject(w io.Writer, details *maestro.ManifestWorkDetails, flags *WatchFlags, last *watchState) error {
+	var data []byte
+	var err error
+	if strings.EqualFold(flags.Output, defaultOutputFormatJSON) {
+		data, err = json.MarshalIndent(details, "", "  ")
+	} else {
+		data, err = yaml.Marshal(details)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal ManifestWork: %w", err)
+	}
+	rendered := strings.TrimRight(string(data), "\n") + "\n"
+	if rendered == last.rendered {
+		return nil
+	}
+
+	fmt.Fprintf(w, "[%s] v%d\n", time.Now().Format("15:04:05"), details.Version)
+	if flags.WatchOutput == watchOutputDiff && last.rendered != "" {
+		diff, err := unifiedDiff(last.rendered, rendered, fmt.Sprintf("v%d", last.version), fmt.Sprintf("v%d", details.Version))
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(w, colorizeDiff(diff))
+	} else {
+		fmt.Fprint(w, rendered)
+	}
+	last.version = details.Version
+	last.rendered = rendered
+	return nil
+}
+
+// unifiedDiff returns a unified diff with three lines of context between two
+// texts, labelling them from and to.
+func unifiedDiff(before, after, from, to string) (string, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(before, "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(after, "\n")),
+		FromFile: from,
+		ToFile:   to,
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to diff ManifestWork: %w", err)
+	}
+	return diff, nil
+}
+
+var (
+	diffAddedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+	diffRemovedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	diffHunkStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("6"))
+)
+
+// colorizeDiff colors added lines green, removed lines red and hunk headers
+// cyan. Colors are dropped automatically when stdout is not a terminal.
+func colorizeDiff(diff string) string {
+	lines := strings.SplitAfter(diff, "\n")
+	var sb strings.Builder
+	for _, line := range lines {
+		text := strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(text, "+++"), strings.HasPrefix(text, "---"):
+			sb.WriteString(text)
+		case strings.HasPrefix(text, "@@"):
+			sb.WriteString(diffHunkStyle.Render(text))
+		case strings.HasPrefix(text, "+"):
+			sb.WriteString(diffAddedStyle.Render(text))
+		case strings.HasPrefix(text, "-"):
+			sb.WriteString(diffRemovedStyle.Render(text))
+		default:
+			sb.WriteString(text)
+		}
+		if strings.HasSuffix(line, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+func TestValidateWatchOutput(t *testing.T) {
+	tests := []struct {
+		value       string
+		expectError bool
+	}{
+		{value: watchOutputStatus},
+		{value: watchOutputFull},
+		{value: watchOutputDiff},
+		{value: "", expectError: true},
+		{value: "Diff", expectError: true},
+		{value: "yaml", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := validateWatchOutput(tt.value)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateWatchOutput(%q) error = %v, expected error: %v", tt.value, err, tt.expectError)
+			}
+			if err != nil && !strings.Contains(err.Error(), "valid: status, full, diff") {
+				t.Errorf("expected the error to list the valid values, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPrintWatchObject(t *testing.T) {
+	details := &maestro.ManifestWorkDetails{
+		Name:       "web",
+		Version:    1,
+		Conditions: []maestro.ConditionSummary{{Type: "Applied", Status: "False"}},
+	}
+	flags := &WatchFlags{Output: "yaml", WatchOutput: watchOutputDiff}
+	last := &watchState{}
+
+	// The first poll prints the whole object, even in diff mode
+	var out bytes.Buffer
+	if err := printWatchObject(&out, details, flags, last); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "] v1\n") || !strings.Contains(out.String(), "name: web") {
+		t.Errorf("expected the whole object on the first poll, got:\n%s", out.String())
+	}
+	if strings.Contains(out.String(), "@@") {
+		t.Errorf("expected no diff on the first poll, got:\n%s", out.String())
+	}
+
+	// Nothing changed: nothing printed
+	out.Reset()
+	if err := printWatchObject(&out, details, flags, last); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no output for an unchanged ManifestWork, got:\n%s", out.String())
+	}
+
+	changed := *details
+	changed.Version = 2
+	changed.Conditions = []maestro.ConditionSummary{{Type: "Applied", Status: "True"}}
+	out.Reset()
+	if err := printWatchObject(&out, &changed, flags, last); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"] v2\n--- v1\n+++ v2\n@@ -1,5 +1,5 @@\n",
+		"\n-- status: \"False\"\n+- status: \"True\"\n",
+		"\n-version: 1\n+version: 2\n",
+		"\n name: web\n", // unchanged lines are context
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in the diff, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestColorizeDiff(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI)
+	defer lipgloss.SetColorProfile(profile)
+
+	diff := "--- v1\n+++ v2\n@@ -1,2 +1,2 @@\n-old\n+new\n same\n"
+	colored := colorizeDiff(diff)
+	lines := strings.Split(colored, "\n")
+	if len(lines) != 7 || lines[6] != "" {
+		t.Fatalf("expected the lines and trailing newline kept, got %q", colored)
+	}
+	for i, want := range []struct {
+		text    string
+		colored bool
+	}{
+		{"--- v1", false}, {"+++ v2", false}, {"@@ -1,2 +1,2 @@", true},
+		{"-old", true}, {"+new", true}, {" same", false},
+	} {
+		if !strings.Contains(lines[i], want.text) {
+			t.Errorf("line %d: expected %q, got %q", i, want.text, lines[i])
+		}
+		if got := strings.Contains(lines[i], "\x1b["); got != want.colored {
+			t.Errorf("line %d (%q): colored = %v, expected %v", i, want.text, got, want.colored)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Package config loads the maestro-cli configuration files, which hold user
+// defaults for command-line flags.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// EnvConfig names the environment variable listing the configuration files to
+// merge, separated like PATH (":" on Linux and macOS), in place of the default
+// file.
+const EnvConfig = "MAESTRO_CONFIG"
+
+// Output formats accepted as the default output
+const (
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+	OutputTable = "table"
+)
+
+// Config holds the settings read from the configuration file. Zero values mean
+// "not set", so the built-in defaults apply.
+type Config struct {
+	// Output is the default --output of get, list and describe, and selects the
+	// TUI's initial detail view: json, yaml or table (the formatted view).
+	Output string `json:"output,omitempty"`
+	// Endpoints are Maestro HTTP endpoints offered on the TUI connect screen,
+	// which probes each one and shows whether it is reachable.
+	Endpoints []string `json:"endpoints,omitempty"`
+	// CurrentContext names the entry of Contexts whose settings apply.
+	CurrentContext string `json:"current-context,omitempty"`
+	// Contexts are named Maestro environments, switched between with
+	// `maestro-cli config use-context`.
+	Contexts map[string]Context `json:"contexts,omitempty"`
+}
+
+// Context is a named Maestro environment.
+type Context struct {
+	// Endpoint is the Maestro HTTP endpoint used when neither --http-endpoint
+	// nor MAESTRO_HTTP_ENDPOINT is set.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// CurrentEndpoint returns the HTTP endpoint of the current context, or "" when
+// no context is selected or it sets none.
+func (c *Config) CurrentEndpoint() string {
+	return c.Contexts[c.CurrentContext].Endpoint
+}
+
+// DefaultPath returns the configuration file location,
+// $XDG_CONFIG_HOME/maestro-cli/config.yaml (~/.config/maestro-cli/config.yaml
+// on Linux), or "" when no config directory can be determined.
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "maestro-cli", "config.yaml")
+}
+
+// Paths returns the configuration files to load, in merge order: the files
+// listed in $MAESTRO_CONFIG when it is set, otherwise DefaultPath.
+func Paths() []string {
+	if list := os.Getenv(EnvConfig); list != "" {
+		var paths []string
+		for _, path := range filepath.SplitList(list) {
+			if path != "" {
+				paths = append(paths, path)
+			}
+		}
+		return paths
+	}
+	if path := DefaultPath(); path != "" {
+		return []string{path}
+	}
+	return nil
+}
+
+// LoadDefault loads and merges the configuration files returned by Paths.
+func LoadDefault() (*Config, error) {
+	return LoadFiles(Paths())
+}
+
+// Load reads and validates the configuration file at path. A missing file, or
+// an empty path, yields an empty Config.
+func Load(path string) (*Config, error) {
+	return LoadFiles([]string{path})
+}
+
+// LoadFiles reads and validates the configuration files at paths and merges
+// them in order, like a KUBECONFIG list: a key set in a later file overrides
+// the same key from an earlier one, and maps are merged key by key, so
+// org-wide defaults can be refined by per-user overrides. Lists are replaced
+// as a whole. Missing files and empty paths are skipped.
+func LoadFiles(paths []string) (*Config, error) {
+	merged := map[string]interface{}{}
+	for _, path := range paths {
+		raw, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		mergeMaps(merged, raw)
+	}
+
+	cfg := &Config{}
+	data, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge config files: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to merge config files: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid merged config: %w", err)
+	}
+	// Checked on the merged config only: a personal file may select a context
+	// defined in an org-wide one.
+	if _, ok := cfg.Contexts[cfg.CurrentContext]; cfg.CurrentContext != "" && !ok {
+		return nil, fmt.Errorf("invalid merged config: current-context %q is not defined under contexts",
+			cfg.CurrentContext)
+	}
+	return cfg, nil
+}
+
+// readFile parses and validates one configuration file on its own, so errors
+// name the file they come from, and returns its keys for merging.
+func readFile(path string) (map[string]interface{}, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	return parseFile(path, data)
+}
+
+// parseFile parses and validates the contents of the configuration file at
+// path.
+func parseFile(path string, data []byte) (map[string]interface{}, error) {
+	cfg := &Config{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return raw, nil
+}
+
+// mergeMaps copies src into dst, recursing into maps present in both.
+func mergeMaps(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeMaps(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// Validate checks the values set in the configuration.
+func (c *Config) Validate() error {
+	valid := []string{OutputJSON, OutputYAML, OutputTable}
+	if c.Output != "" && !slices.Contains(valid, strings.ToLower(c.Output)) {
+		return fmt.Errorf("unknown output %q (valid: %s)", c.Output, strings.Join(valid, ", "))
+	}
+	for _, endpoint := range c.Endpoints {
+		if err := validateEndpoint(endpoint); err != nil {
+			return err
+		}
+	}
+	for name, ctx := range c.Contexts {
+		if ctx.Endpoint == "" {
+			continue
+		}
+		if err := validateEndpoint(ctx.Endpoint); err != nil {
+			return fmt.Errorf("context %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid endpoint %q: expected an http:// or https:// URL", endpoint)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     *string // nil means the file does not exist
+		expected    Config
+		expectError bool
+	}{
+		{name: "missing file", expected: Config{}},
+		{name: "empty file", content: ptr(""), expected: Config{}},
+		{name: "output", content: ptr("output: json\n"), expected: Config{Output: "json"}},
+		{name: "table output", content: ptr("output: Table\n"), expected: Config{Output: "Table"}},
+		{name: "unknown output", content: ptr("output: csv\n"), expectError: true},
+		{name: "unknown key", content: ptr("outptu: json\n"), expectError: true},
+		{name: "malformed", content: ptr("output: [json\n"), expectError: true},
+		{
+			name:     "endpoints",
+			content:  ptr("endpoints:\n  - http://maestro-a:8000\n  - https://maestro-b.example.com\n"),
+			expected: Config{Endpoints: []string{"http://maestro-a:8000", "https://maestro-b.example.com"}},
+		},
+		{name: "endpoint without scheme", content: ptr("endpoints: [maestro-a:8000]\n"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if tt.content != nil {
+				if err := os.WriteFile(path, []byte(*tt.content), 0o600); err != nil {
+					t.Fatalf("failed to write config: %v", err)
+				}
+			}
+
+			cfg, err := Load(path)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got config %+v", cfg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(*cfg, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, *cfg)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestLoadFiles(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       []*string // nil entries are files that do not exist
+		expected    Config
+		expectError bool
+	}{
+		{name: "no files", expected: Config{}},
+		{
+			name:     "later file overrides a key",
+			files:    []*string{ptr("output: yaml\nendpoints: [http://org:8000]\n"), ptr("output: json\n")},
+			expected: Config{Output: "json", Endpoints: []string{"http://org:8000"}},
+		},
+		{
+			name:     "lists are replaced, not appended",
+			files:    []*string{ptr("endpoints: [http://org:8000]\n"), ptr("endpoints: [http://me:8000]\n")},
+			expected: Config{Endpoints: []string{"http://me:8000"}},
+		},
+		{
+			name:     "missing files are skipped",
+			files:    []*string{nil, ptr("output: table\n"), nil},
+			expected: Config{Output: "table"},
+		},
+		{name: "invalid file fails", files: []*string{ptr("output: json\n"), ptr("output: csv\n")}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			var paths []string
+			for i, content := range tt.files {
+				path := filepath.Join(dir, fmt.Sprintf("config-%d.yaml", i))
+				if content != nil {
+					if err := os.WriteFile(path, []byte(*content), 0o600); err != nil {
+						t.Fatalf("failed to write config: %v", err)
+					}
+				}
+				paths = append(paths, path)
+			}
+
+			cfg, err := LoadFiles(paths)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got config %+v", cfg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(*cfg, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, *cfg)
+			}
+		})
+	}
+}
+
+func TestPaths(t *testing.T) {
+	list := strings.Join([]string{"/etc/maestro-cli/org.yaml", "", "/home/me/maestro.yaml"}, string(os.PathListSeparator))
+	t.Setenv(EnvConfig, list)
+	expected := []string{"/etc/maestro-cli/org.yaml", "/home/me/maestro.yaml"}
+	if got := Paths(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	yamlv3 "go.yaml.in/yaml/v3"
+)
+
+// SettableKeys describes the keys accepted by Set.
+var SettableKeys = []string{"output", "endpoints", "current-context", "context.<name>.endpoint"}
+
+// Set changes key to value in the configuration file at path and writes it
+// back, keeping the file's comments and the order of its keys. An empty value
+// removes the key. endpoints takes a comma-separated list, and
+// context.<name>.endpoint sets the endpoint of the named context, creating it
+// when needed. The result is validated before anything is written; a missing
+// file is created.
+func Set(path, key, value string) error {
+	fields, node, err := settableNode(key, value)
+	if err != nil {
+		return err
+	}
+
+	doc, err := readDocument(path)
+	if err != nil {
+		return err
+	}
+	root := doc.Content[0]
+	if node == nil {
+		removeField(root, fields)
+	} else {
+		setField(root, fields, node)
+	}
+
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode config file %s: %w", path, err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("failed to encode config file %s: %w", path, err)
+	}
+	if _, err := parseFile(path, buf.Bytes()); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	// Same permissions as other files holding user settings: owner read/write only
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// settableNode maps a Set key to its field path in the file and the node to
+// store there; a nil node means the key is removed.
+func settableNode(key, value string) ([]string, *yamlv3.Node, error) {
+	var fields []string
+	switch {
+	case key == "output", key == "current-context", key == "endpoints":
+		fields = []string{key}
+	case strings.HasPrefix(key, "context.") && strings.HasSuffix(key, ".endpoint"):
+		name := strings.TrimSuffix(strings.TrimPrefix(key, "context."), ".endpoint")
+		if name == "" {
+			return nil, nil, fmt.Errorf("missing context name in %q", key)
+		}
+		fields = []string{"contexts", name, "endpoint"}
+	default:
+		return nil, nil, fmt.Errorf("unknown config key %q (valid: %s)", key, strings.Join(SettableKeys, ", "))
+	}
+
+	if value == "" {
+		return fields, nil, nil
+	}
+	if key != "endpoints" {
+		return fields, scalarNode(value), nil
+	}
+	list := &yamlv3.Node{Kind: yamlv3.SequenceNode, Tag: "!!seq"}
+	for _, endpoint := range strings.Split(value, ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			list.Content = append(list.Content, scalarNode(endpoint))
+		}
+	}
+	return fields, list, nil
+}
+
+// readDocument parses the file at path as a YAML document whose root is a
+// mapping; a missing or empty file yields an empty one.
+func readDocument(path string) (*yamlv3.Node, error) {
+	empty := &yamlv3.Node{
+		Kind:    yamlv3.DocumentNode,
+		Content: []*yamlv3.Node{{Kind: yamlv3.MappingNode, Tag: "!!map"}},
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return empty, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	doc := &yamlv3.Node{}
+	if err := yamlv3.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		// Keep comments of a file that holds nothing else
+		empty.HeadComment = doc.HeadComment
+		return empty, nil
+	}
+	if doc.Content[0].Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("failed to parse config file %s: expected a mapping at the top level", path)
+	}
+	return doc, nil
+}
+
+// setField stores value under the nested keys fields of mapping, creating
+// intermediate mappings as needed. A replaced value keeps its comments.
+func setField(mapping *yamlv3.Node, fields []string, value *yamlv3.Node) {
+	for _, field := range fields[:len(fields)-1] {
+		next := lookupField(mapping, field)
+		switch {
+		case next == nil:
+			next = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+			mapping.Content = append(mapping.Content, scalarNode(field), next)
+		case next.Kind != yamlv3.MappingNode:
+			*next = yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map", LineComment: next.LineComment}
+		}
+		mapping = next
+	}
+
+	last := fields[len(fields)-1]
+	if existing := lookupField(mapping, last); existing != nil {
+		value.HeadComment, value.LineComment, value.FootComment =
+			existing.HeadComment, existing.LineComment, existing.FootComment
+		*existing = *value
+		return
+	}
+	mapping.Content = append(mapping.Content, scalarNode(last), value)
+}
+
+// removeField deletes the nested keys fields from mapping when present.
+func removeField(mapping *yamlv3.Node, fields []string) {
+	for i, field := range fields {
+		if i < len(fields)-1 {
+			if mapping = lookupField(mapping, field); mapping == nil || mapping.Kind != yamlv3.MappingNode {
+				return
+			}
+			continue
+		}
+		for j := 0; j+1 < len(mapping.Content); j += 2 {
+			if mapping.Content[j].Value == field {
+				mapping.Content = append(mapping.Content[:j], mapping.Content[j+2:]...)
+				return
+			}
+		}
+	}
+}
+
+// lookupField returns the value node of key in mapping, or nil.
+func lookupField(mapping *yamlv3.Node, key string) *yamlv3.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func scalarNode(value string) *yamlv3.Node {
+	return &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: value}
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     *string // nil means the file does not exist
+		key         string
+		value       string
+		expected    string
+		expectError bool
+	}{
+		{name: "creates a missing file", key: "output", value: "json", expected: "output: json\n"},
+		{
+			name:     "replaces a value and keeps comments",
+			content:  ptr("# defaults\noutput: yaml # for scripts\n"),
+			key:      "output",
+			value:    "json",
+			expected: "# defaults\noutput: json # for scripts\n",
+		},
+		{
+			name:     "adds a context next to existing ones",
+			content:  ptr("contexts:\n  dev:\n    endpoint: http://dev:8000 # local\n"),
+			key:      "context.prod.endpoint",
+			value:    "https://prod.example.com",
+			expected: "contexts:\n  dev:\n    endpoint: http://dev:8000 # local\n  prod:\n    endpoint: https://prod.example.com\n",
+		},
+		{
+			name:     "endpoints from a comma-separated list",
+			key:      "endpoints",
+			value:    "http://a:8000, http://b:8000",
+			expected: "endpoints:\n  - http://a:8000\n  - http://b:8000\n",
+		},
+		{
+			name:     "empty value removes the key",
+			content:  ptr("output: json\ncurrent-context: dev\n"),
+			key:      "current-context",
+			expected: "output: json\n",
+		},
+		{name: "invalid value", key: "output", value: "csv", expectError: true},
+		{name: "invalid endpoint", key: "context.dev.endpoint", value: "dev:8000", expectError: true},
+		{name: "unknown key", key: "outptu", value: "json", expectError: true},
+		{name: "missing context name", key: "context..endpoint", value: "http://a:8000", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "maestro-cli", "config.yaml")
+			if tt.content != nil {
+				if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+					t.Fatalf("failed to create config dir: %v", err)
+				}
+				if err := os.WriteFile(path, []byte(*tt.content), 0o600); err != nil {
+					t.Fatalf("failed to write config: %v", err)
+				}
+			}
+
+			err := Set(path, tt.key, tt.value)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if tt.content == nil {
+					if _, statErr := os.Stat(path); !os.IsNotExist(statErr) {
+						t.Error("expected nothing to be written for an invalid change")
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read config: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expected, data)
+			}
+		})
+	}
+}
+
+func TestLoadFilesCurrentContext(t *testing.T) {
+	dir := t.TempDir()
+	org := filepath.Join(dir, "org.yaml")
+	mine := filepath.Join(dir, "mine.yaml")
+	if err := os.WriteFile(org, []byte("contexts:\n  prod:\n    endpoint: https://prod.example.com\n"), 0o600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if err := os.WriteFile(mine, []byte("current-context: prod\n"), 0o600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	cfg, err := LoadFiles([]string{org, mine})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := cfg.CurrentEndpoint(); got != "https://prod.example.com" {
+		t.Errorf("expected the prod endpoint, got %q", got)
+	}
+
+	if _, err := LoadFiles([]string{mine}); err == nil {
+		t.Error("expected an error for a current-context that is not defined")
+	}
+}
@@ -0,0 +1,67 @@
+package maestro
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormatAge renders d in kubectl style, e.g. 45s, 12m, 3h, 5d. A negative d,
+// from a timestamp ahead of the local clock, renders as "0s" rather than a
+// confusing "-3m".
+func FormatAge(d time.Duration) string {
+	switch {
+	case d < 0:
+		return "0s"
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
+
+// TimestampAge returns how long before now an RFC3339 timestamp was. A
+// timestamp in the future, which means the clocks of Maestro and this machine
+// disagree or a time zone was applied twice, is clamped to zero and reported
+// as skewed so callers can warn.
+func TimestampAge(timestamp string, now time.Time) (age time.Duration, skewed bool, err error) {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return 0, false, err
+	}
+	age = now.Sub(t)
+	if age < 0 {
+		return 0, true, nil
+	}
+	return age, false, nil
+}
+
+// LastTransition returns the latest lastTransitionTime among the conditions
+// in sets, which tells how long the current status has held. ok is false when
+// no condition carries an RFC3339 transition time.
+func LastTransition(sets ...[]ConditionSummary) (last time.Time, ok bool) {
+	for _, conds := range sets {
+		for _, c := range conds {
+			t, err := time.Parse(time.RFC3339, c.LastTransitionTime)
+			if err == nil && (!ok || t.After(last)) {
+				last, ok = t, true
+			}
+		}
+	}
+	return last, ok
+}
+
+// CountClockSkewed returns how many of works were created after now by the
+// local clock, so a listing can warn once instead of per row.
+func CountClockSkewed(works []ResourceBundleSummary, now time.Time) int {
+	count := 0
+	for _, w := range works {
+		if _, skewed, err := TimestampAge(w.CreatedAt, now); err == nil && skewed {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,73 @@
+package maestro
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimestampAge(t *testing.T) {
+	now := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		timestamp   string
+		expectAge   string
+		expectSkew  bool
+		expectError bool
+	}{
+		{name: "seconds", timestamp: "2024-01-02T09:59:15Z", expectAge: "45s"},
+		{name: "minutes", timestamp: "2024-01-02T09:48:00Z", expectAge: "12m"},
+		{name: "hours", timestamp: "2024-01-02T07:00:00Z", expectAge: "3h"},
+		{name: "days", timestamp: "2023-12-28T10:00:00Z", expectAge: "5d"},
+		{name: "other time zone", timestamp: "2024-01-02T11:55:00+02:00", expectAge: "5m"},
+		{name: "future clamps to zero", timestamp: "2024-01-02T10:03:00Z", expectAge: "0s", expectSkew: true},
+		{name: "not a timestamp", timestamp: "yesterday", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			age, skewed, err := TimestampAge(tt.timestamp, now)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := FormatAge(age); got != tt.expectAge {
+				t.Errorf("expected age %q, got %q", tt.expectAge, got)
+			}
+			if skewed != tt.expectSkew {
+				t.Errorf("expected skewed %v, got %v", tt.expectSkew, skewed)
+			}
+		})
+	}
+}
+
+func TestCountClockSkewed(t *testing.T) {
+	now := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	works := []ResourceBundleSummary{
+		{Name: "past", CreatedAt: "2024-01-02T09:00:00Z"},
+		{Name: "future", CreatedAt: "2024-01-02T10:03:00Z"},
+		{Name: "unparsable", CreatedAt: ""},
+	}
+	if got := CountClockSkewed(works, now); got != 1 {
+		t.Errorf("expected 1 skewed ManifestWork, got %d", got)
+	}
+}
+
+func TestLastTransition(t *testing.T) {
+	work := []ConditionSummary{
+		{Type: "Applied", LastTransitionTime: "2024-01-02T10:00:00Z"},
+		{Type: "Available", LastTransitionTime: "not a time"},
+	}
+	resource := []ConditionSummary{{Type: "Available", LastTransitionTime: "2024-01-02T10:05:00Z"}}
+	last, ok := LastTransition(work, resource)
+	if !ok || !last.Equal(time.Date(2024, 1, 2, 10, 5, 0, 0, time.UTC)) {
+		t.Errorf("expected 10:05 from the resource condition, got %v (ok %v)", last, ok)
+	}
+	if _, ok := LastTransition(work[1:], nil); ok {
+		t.Error("expected no transition time without a parseable one")
+	}
+}
@@ -6,9 +6,12 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -31,9 +34,41 @@ const (
 	// DefaultPollInterval is the default interval for polling ManifestWork status
 	DefaultPollInterval = 1 * time.Second
 
+	// SourceIDAnnotation records on each applied ManifestWork the source ID that applied it,
+	// so the owning pipeline can be identified from the HTTP API (which does not expose the source)
+	SourceIDAnnotation = "hyperfleet.io/source-id"
+
+	// DefaultPageSize is how many resource bundles are fetched per list request
+	DefaultPageSize = 100
+
+	// MaxPageSize caps the page size so a single list response stays a manageable size
+	MaxPageSize = 1000
+
+	// DefaultMaxRetries is how many times a failed idempotent HTTP request is retried
+	DefaultMaxRetries = 3
+
+	// MaxRetries caps --max-retries, so a down server fails within a bounded time
+	MaxRetries = 10
+
+	// DefaultRetryBackoff is the wait before the first retry; each further retry doubles it
+	DefaultRetryBackoff = 500 * time.Millisecond
+
+	// maxRetryBackoff caps a single wait between retries, including one asked for by Retry-After
+	maxRetryBackoff = 10 * time.Second
+
+	// DefaultAPIPrefix is the path of the Maestro REST API under the HTTP endpoint
+	DefaultAPIPrefix = "/api/maestro/v1"
+
+	// WaitProgressInterval is how often a wait logs (at debug level) the time left before its deadline
+	WaitProgressInterval = 30 * time.Second
+
 	// Status constants
 	statusTrue    = "True"
 	statusApplied = "Applied"
+
+	// Condition types an agent may report while a work's resources are being removed
+	conditionTerminating = "Terminating"
+	conditionDeleted     = "Deleted"
 )
 
 // Client represents a Maestro client
@@ -41,6 +76,7 @@ type Client struct {
 	workClient workv1client.WorkV1Interface // nil for HTTP-only client
 	httpClient *openapi.APIClient
 	sourceID   string
+	pageSize   int32              // resource bundles fetched per list request
 	cancelFunc context.CancelFunc // cancel function for gRPC context
 }
 
@@ -56,19 +92,165 @@ type ClientConfig struct {
 	GRPCClientToken     string
 	GRPCClientTokenFile string
 	SourceID            string // Source ID for CloudEvents subscription (default: "maestro-cli")
+	PageSize            int    // Resource bundles and consumers per list request (default: DefaultPageSize)
+	// ImpersonateUser and ImpersonateGroups set the Impersonate-User and
+	// Impersonate-Group headers on HTTP requests, like kubectl --as and
+	// --as-group. The server must support impersonation; gRPC is unaffected.
+	ImpersonateUser   string
+	ImpersonateGroups []string
+	// TLSMinVersion is the lowest TLS version accepted for HTTP and gRPC
+	// connections: "1.2" or "1.3" (see TLSMinVersions). Empty keeps Go's
+	// default of TLS 1.2.
+	TLSMinVersion string
+	// APIPrefix replaces DefaultAPIPrefix in HTTP request paths, for servers
+	// that serve the API under another path or version. Empty keeps the
+	// default.
+	APIPrefix string
+	// MaxRetries is how many times an idempotent HTTP request (GET or HEAD)
+	// is retried after a connection error or a 429, 502, 503 or 504 response;
+	// 0 disables retries. RetryBackoff is the wait before the first retry,
+	// doubling for each further one (DefaultRetryBackoff when 0). Retries count
+	// against the request's 30-second limit and stop when its context ends.
+	MaxRetries   int
+	RetryBackoff time.Duration
+	// Logger receives client logs, including each HTTP request's timing at debug
+	// level; nil uses an info-level text logger, so timings stay hidden.
+	Logger *logger.Logger
 }
 
-// NewHTTPClient creates an HTTP-only Maestro client (no gRPC connection)
-// Use this for commands that only need HTTP API: list, get, watch (polling)
-func NewHTTPClient(config ClientConfig) (*Client, error) {
-	// Create a basic logger for client operations
-	log := logger.New(logger.Config{
+// logger returns the configured logger or a basic info-level one
+func (config ClientConfig) logger() *logger.Logger {
+	if config.Logger != nil {
+		return config.Logger
+	}
+	return logger.New(logger.Config{
 		Level:  "info",
 		Format: "text",
 	})
+}
+
+// ValidatePageSize checks a --page-size value: between 1 and MaxPageSize. A
+// ClientConfig left at 0 uses DefaultPageSize without being validated.
+func ValidatePageSize(size int) error {
+	if size < 1 || size > MaxPageSize {
+		return fmt.Errorf("page size must be between 1 and %d, got %d", MaxPageSize, size)
+	}
+	return nil
+}
+
+// ValidateAPIPrefix checks an --api-prefix value; empty keeps DefaultAPIPrefix.
+func ValidateAPIPrefix(prefix string) error {
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		return fmt.Errorf("API prefix must start with \"/\", got %q", prefix)
+	}
+	return nil
+}
+
+// ValidateRetries checks --max-retries and --retry-backoff values.
+func ValidateRetries(maxRetries int, backoff time.Duration) error {
+	if maxRetries < 0 || maxRetries > MaxRetries {
+		return fmt.Errorf("max retries must be between 0 and %d, got %d", MaxRetries, maxRetries)
+	}
+	if backoff < 0 {
+		return fmt.Errorf("retry backoff must not be negative, got %s", backoff)
+	}
+	return nil
+}
+
+// retry wraps next so transient failures of idempotent requests are retried
+// with exponential backoff, or returns next unchanged when MaxRetries is 0.
+func (config ClientConfig) retry(next http.RoundTripper, log *logger.Logger) http.RoundTripper {
+	if config.MaxRetries <= 0 {
+		return next
+	}
+	backoff := config.RetryBackoff
+	if backoff <= 0 {
+		backoff = DefaultRetryBackoff
+	}
+	return &retryTransport{next: next, maxRetries: config.MaxRetries, backoff: backoff, log: log}
+}
+
+// rewriteAPIPrefix wraps next so request paths use APIPrefix instead of
+// DefaultAPIPrefix, or returns next unchanged when the default applies.
+func (config ClientConfig) rewriteAPIPrefix(next http.RoundTripper) http.RoundTripper {
+	prefix := strings.TrimRight(config.APIPrefix, "/")
+	if config.APIPrefix == "" || prefix == DefaultAPIPrefix {
+		return next
+	}
+	return &apiPrefixTransport{next: next, prefix: prefix}
+}
+
+// TLSMinVersions are the accepted ClientConfig.TLSMinVersion values
+var TLSMinVersions = []string{"1.2", "1.3"}
+
+// ValidateTLSMinVersion checks a --tls-min-version value; empty is allowed.
+func ValidateTLSMinVersion(version string) error {
+	_, err := ClientConfig{TLSMinVersion: version}.tlsMinVersion()
+	return err
+}
+
+// tlsMinVersion returns the tls.Version* constant for TLSMinVersion, or 0 when
+// unset, which leaves Go's default minimum in place.
+func (config ClientConfig) tlsMinVersion() (uint16, error) {
+	switch config.TLSMinVersion {
+	case "":
+		return 0, nil
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("unsupported TLS minimum version %q (supported: %s)",
+		config.TLSMinVersion, strings.Join(TLSMinVersions, ", "))
+}
+
+// validateImpersonation rejects groups without a user to impersonate, as
+// kubectl does.
+func (config ClientConfig) validateImpersonation() error {
+	if config.ImpersonateUser == "" && len(config.ImpersonateGroups) > 0 {
+		return fmt.Errorf("impersonating groups requires a user to impersonate (--as)")
+	}
+	return nil
+}
+
+// impersonate wraps next so requests carry the impersonation headers, or
+// returns next unchanged when no user is impersonated.
+func (config ClientConfig) impersonate(next http.RoundTripper) http.RoundTripper {
+	if config.ImpersonateUser == "" {
+		return next
+	}
+	return &impersonatingTransport{next: next, user: config.ImpersonateUser, groups: config.ImpersonateGroups}
+}
+
+// pageSize returns the configured page size, or DefaultPageSize when unset.
+func (config ClientConfig) pageSize() int32 {
+	if config.PageSize <= 0 || config.PageSize > MaxPageSize {
+		return DefaultPageSize
+	}
+	return int32(config.PageSize)
+}
+
+// NewHTTPClient creates an HTTP-only Maestro client (no gRPC connection)
+// Use this for commands that only need HTTP API: list, get, watch (polling)
+func NewHTTPClient(config ClientConfig) (*Client, error) {
+	log := config.logger()
+	if err := config.validateImpersonation(); err != nil {
+		return nil, err
+	}
+	if err := ValidateAPIPrefix(config.APIPrefix); err != nil {
+		return nil, err
+	}
+	if err := ValidateRetries(config.MaxRetries, config.RetryBackoff); err != nil {
+		return nil, err
+	}
+	minTLS, err := config.tlsMinVersion()
+	if err != nil {
+		return nil, err
+	}
 
 	// Create custom HTTP client to avoid connection issues
-	httpClient := createHTTPClient(config.GRPCInsecure, log)
+	httpClient := createHTTPClient(config.GRPCInsecure, minTLS, log)
+	httpClient.Transport = config.impersonate(config.rewriteAPIPrefix(config.retry(httpClient.Transport, log)))
 
 	// Create Maestro HTTP API client
 	maestroAPIClient := openapi.NewAPIClient(&openapi.Configuration{
@@ -82,6 +264,7 @@ func NewHTTPClient(config ClientConfig) (*Client, error) {
 		workClient: nil, // No gRPC client
 		httpClient: maestroAPIClient,
 		sourceID:   "",
+		pageSize:   config.pageSize(),
 	}, nil
 }
 
@@ -90,18 +273,28 @@ func NewHTTPClient(config ClientConfig) (*Client, error) {
 // The provided context is used for the gRPC connection lifecycle.
 // When the context is cancelled (e.g., on SIGINT/SIGTERM), the gRPC connection will be closed.
 func NewClient(ctx context.Context, config ClientConfig) (*Client, error) {
-	// Create a basic logger for client operations
-	log := logger.New(logger.Config{
-		Level:  "info",
-		Format: "text",
-	})
+	log := config.logger()
+	if err := config.validateImpersonation(); err != nil {
+		return nil, err
+	}
+	if err := ValidateAPIPrefix(config.APIPrefix); err != nil {
+		return nil, err
+	}
+	if err := ValidateRetries(config.MaxRetries, config.RetryBackoff); err != nil {
+		return nil, err
+	}
+	minTLS, err := config.tlsMinVersion()
+	if err != nil {
+		return nil, err
+	}
 
 	// Create a cancellable context derived from the parent context
 	// This allows us to cancel the gRPC connection on Close() or when parent context is cancelled
 	grpcCtx, cancel := context.WithCancel(ctx)
 
 	// Create custom HTTP client with proper TLS config
-	httpClient := createHTTPClient(config.GRPCInsecure, log)
+	httpClient := createHTTPClient(config.GRPCInsecure, minTLS, log)
+	httpClient.Transport = config.impersonate(config.rewriteAPIPrefix(config.retry(httpClient.Transport, log)))
 
 	// Create Maestro HTTP API client
 	maestroAPIClient := openapi.NewAPIClient(&openapi.Configuration{
@@ -120,6 +313,9 @@ func NewClient(ctx context.Context, config ClientConfig) (*Client, error) {
 			cancel() // Clean up cancel function on error
 			return nil, fmt.Errorf("failed to create TLS config: %w", err)
 		}
+		if minTLS != 0 {
+			tlsConfig.MinVersion = minTLS
+		}
 	}
 
 	// Create gRPC dialer
@@ -167,6 +363,7 @@ func NewClient(ctx context.Context, config ClientConfig) (*Client, error) {
 		workClient: workClient,
 		httpClient: maestroAPIClient,
 		sourceID:   sourceID,
+		pageSize:   config.pageSize(),
 		cancelFunc: cancel,
 	}, nil
 }
@@ -199,8 +396,12 @@ func (c *Client) SourceID() string {
 }
 
 // createHTTPClient creates an HTTP client with proper configuration
-// to avoid connection reset issues
-func createHTTPClient(insecure bool, log *logger.Logger) *http.Client {
+// to avoid connection reset issues. Compression stays enabled: the transport
+// asks for gzip and decompresses responses transparently, which shrinks the
+// large bundles a watch fetches repeatedly. Setting Accept-Encoding by hand
+// would turn that off. minTLS is the lowest TLS version to accept; 0 keeps
+// Go's default.
+func createHTTPClient(insecure bool, minTLS uint16, log *logger.Logger) *http.Client {
 	transport := &http.Transport{
 		DisableKeepAlives:     true, // Disable keep-alive to avoid connection reuse issues
 		MaxIdleConns:          10,
@@ -218,39 +419,291 @@ func createHTTPClient(insecure bool, log *logger.Logger) *http.Client {
 			logger.Fields{"reason": "grpc-insecure flag is set"})
 	}
 
+	var next http.RoundTripper = transport
+	if minTLS != 0 {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{} //nolint:gosec // MinVersion is set below
+		}
+		transport.TLSClientConfig.MinVersion = minTLS
+		next = &tlsVersionTransport{next: transport, minVersion: minTLS}
+	}
+
 	return &http.Client{
 		Timeout:   30 * time.Second,
-		Transport: transport,
+		Transport: &timingTransport{next: next, log: log},
+	}
+}
+
+// ProbeEndpoint checks that a Maestro HTTP endpoint answers. Any HTTP response
+// below 500 counts as reachable, since an unauthenticated probe may well be
+// refused; connection failures, TLS errors and server errors do not. ctx
+// bounds how long the probe may take. The probe honours the GRPCInsecure,
+// TLSMinVersion and APIPrefix of config, so it reaches the endpoint the way a
+// client connecting with config would; config.HTTPEndpoint is not used.
+func ProbeEndpoint(ctx context.Context, endpoint string, config ClientConfig) error {
+	minTLS, err := config.tlsMinVersion()
+	if err != nil {
+		return err
+	}
+	prefix := DefaultAPIPrefix
+	if p := strings.TrimRight(config.APIPrefix, "/"); p != "" {
+		prefix = p
+	}
+	// Probes run behind the TUI, so the insecure-mode warning must stay quiet
+	httpClient := createHTTPClient(config.GRPCInsecure, minTLS, logger.New(logger.Config{Level: "error", Format: "text"}))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(endpoint, "/")+prefix, nil)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck // nothing is read from the body
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("server error: %s", resp.Status)
+	}
+	return nil
+}
+
+// timingTransport logs each HTTP request's method, path, status and duration
+// at debug level, so --verbose shows which endpoints are slow. The duration
+// runs until the response headers arrive; reading the body is not included.
+type timingTransport struct {
+	next http.RoundTripper
+	log  *logger.Logger
+}
+
+func (t *timingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields := logger.Fields{
+		"method":   req.Method,
+		"path":     req.URL.Path,
+		"duration": time.Since(start).String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = resp.StatusCode
+	}
+	t.log.Debug(req.Context(), "HTTP request", fields)
+	return resp, err
+}
+
+// tlsVersionTransport explains handshake failures caused by the server not
+// supporting the minimum TLS version, which Go reports only as a protocol
+// version alert or an unsupported version chosen by the server.
+type tlsVersionTransport struct {
+	next       http.RoundTripper
+	minVersion uint16
+}
+
+func (t *tlsVersionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil && isTLSVersionError(err) {
+		return nil, fmt.Errorf("TLS handshake with %s failed: the server does not support %s or later "+
+			"(lower --tls-min-version to connect): %w", req.URL.Host, tls.VersionName(t.minVersion), err)
+	}
+	return resp, err
+}
+
+// isTLSVersionError reports whether err is a TLS handshake failure over the
+// protocol version, raised by either side.
+func isTLSVersionError(err error) bool {
+	var alert tls.AlertError
+	if stderrors.As(err, &alert) && alert == tlsAlertProtocolVersion {
+		return true
+	}
+	return strings.Contains(err.Error(), "tls: protocol version not supported") ||
+		strings.Contains(err.Error(), "tls: server selected unsupported protocol version")
+}
+
+// tlsAlertProtocolVersion is the protocol_version alert of RFC 8446
+const tlsAlertProtocolVersion = 70
+
+// apiPrefixTransport replaces DefaultAPIPrefix, which the generated API client
+// puts in every request path, with prefix. HTTPEndpoint may carry a path of
+// its own in front of it.
+type apiPrefixTransport struct {
+	next   http.RoundTripper
+	prefix string
+}
+
+func (t *apiPrefixTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base, rest, found := strings.Cut(req.URL.Path, DefaultAPIPrefix)
+	if !found || (rest != "" && !strings.HasPrefix(rest, "/")) {
+		return t.next.RoundTrip(req)
+	}
+	// RoundTrip must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.URL.Path = base + t.prefix + rest
+	if raw := req.URL.RawPath; raw != "" {
+		rawBase, rawRest, _ := strings.Cut(raw, DefaultAPIPrefix)
+		req.URL.RawPath = rawBase + t.prefix + rawRest
 	}
+	return t.next.RoundTrip(req)
+}
+
+// retryTransport retries GET and HEAD requests that fail with a connection
+// error or a 429, 502, 503 or 504 response, waiting backoff before the first
+// retry and doubling the wait each time; a Retry-After of a few seconds is
+// honored. Certificate and TLS version failures are not retried, since they
+// will not go away. The last attempt's response or error is returned.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+	log        *logger.Logger
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+	delay := t.backoff
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if attempt > t.maxRetries || req.Context().Err() != nil || !isTransientFailure(resp, err) {
+			return resp, err
+		}
+
+		wait := delay
+		fields := logger.Fields{"method": req.Method, "path": req.URL.Path, "attempt": attempt}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			if after, convErr := strconv.Atoi(resp.Header.Get("Retry-After")); convErr == nil && after > 0 {
+				wait = max(wait, time.Duration(after)*time.Second)
+			}
+			resp.Body.Close() //nolint:errcheck,gosec // the response is discarded for the retry
+		}
+		wait = min(wait, maxRetryBackoff)
+		fields["backoff"] = wait.String()
+		t.log.Debug(req.Context(), "Retrying HTTP request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, fmt.Errorf("giving up after %d attempt(s): %w", attempt, req.Context().Err())
+		case <-timer.C:
+		}
+		delay = min(delay*2, maxRetryBackoff)
+	}
+}
+
+// isTransientFailure reports whether a request's outcome may succeed when
+// retried: a connection-level error, or a rate-limit or gateway response.
+func isTransientFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		var certErr *tls.CertificateVerificationError
+		var unknownAuthority x509.UnknownAuthorityError
+		return !stderrors.As(err, &certErr) && !stderrors.As(err, &unknownAuthority) && !isTLSVersionError(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// impersonatingTransport sets the impersonation headers on each request. A
+// request the server refuses with 401 or 403 fails with an error naming the
+// impersonated user, since a server without impersonation support, or
+// credentials not allowed to impersonate, are the likely cause.
+type impersonatingTransport struct {
+	next   http.RoundTripper
+	user   string
+	groups []string
+}
+
+func (t *impersonatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrip must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.Header.Set("Impersonate-User", t.user)
+	for _, group := range t.groups {
+		req.Header.Add("Impersonate-Group", group)
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		resp.Body.Close() //nolint:errcheck,gosec // the response is replaced by the error
+		return nil, fmt.Errorf("request as %q was rejected (%s): check that the server supports impersonation "+
+			"and allows your credentials to impersonate", t.user, resp.Status)
+	}
+	return resp, nil
 }
 
 // ConsumerInfo holds basic info about a Maestro consumer
 type ConsumerInfo struct {
-	ID   string
-	Name string
+	ID     string            `json:"id" yaml:"id"`
+	Name   string            `json:"name" yaml:"name"`
+	Labels map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
 }
 
 // ListConsumers lists all consumers from Maestro HTTP API
 func (c *Client) ListConsumers(ctx context.Context) ([]string, error) {
-	consumerList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).Execute()
+	consumers, err := c.ListConsumersWithDetails(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list consumers: %w", err)
+		return nil, err
 	}
 
-	names := make([]string, 0, len(consumerList.Items))
-	for _, consumer := range consumerList.Items {
-		if consumer.Name != nil {
-			names = append(names, *consumer.Name)
+	names := make([]string, 0, len(consumers))
+	for _, consumer := range consumers {
+		if consumer.Name != "" {
+			names = append(names, consumer.Name)
 		}
 	}
 	return names, nil
 }
 
-// ListConsumersWithDetails lists all consumers and returns ConsumerInfo structs
+// ListConsumersWithDetails lists all consumers and returns ConsumerInfo structs,
+// fetching every page of a server that paginates them. A consumer seen on an
+// earlier page is skipped, as one created meanwhile shifts the later pages,
+// and a page with nothing new ends the listing, so a server that ignores the
+// page number does not loop forever.
 func (c *Client) ListConsumersWithDetails(ctx context.Context) ([]ConsumerInfo, error) {
-	consumerList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).Execute()
+	result := []ConsumerInfo{}
+	seen := map[string]bool{}
+	for page := 1; ; page++ {
+		consumers, total, err := c.ListConsumersPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, consumer := range consumers {
+			if seen[consumer.ID] {
+				continue
+			}
+			seen[consumer.ID] = true
+			result = append(result, consumer)
+			added++
+		}
+		if added == 0 || len(result) >= total {
+			return result, nil
+		}
+	}
+}
+
+// ListConsumersPage returns one page of consumers, numbered from 1 with
+// c.pageSize consumers per page, and the total number of consumers the
+// server reports, so a caller can show the first page while it loads the
+// rest.
+func (c *Client) ListConsumersPage(ctx context.Context, page int) ([]ConsumerInfo, int, error) {
+	size := c.pageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	consumerList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).
+		Page(int32(page)).
+		Size(size).
+		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list consumers: %w", err)
+		return nil, 0, fmt.Errorf("failed to list consumers: %w", err)
 	}
 
 	result := make([]ConsumerInfo, 0, len(consumerList.Items))
@@ -262,16 +715,22 @@ func (c *Client) ListConsumersWithDetails(ctx context.Context) ([]ConsumerInfo,
 		if consumer.Name != nil {
 			info.Name = *consumer.Name
 		}
+		if consumer.Labels != nil {
+			info.Labels = *consumer.Labels
+		}
 		result = append(result, info)
 	}
-	return result, nil
+	return result, int(consumerList.Total), nil
 }
 
-// CreateConsumer creates a new consumer with the given name
-func (c *Client) CreateConsumer(ctx context.Context, name string) (*ConsumerInfo, error) {
+// CreateConsumer creates a new consumer with the given name and optional labels
+func (c *Client) CreateConsumer(ctx context.Context, name string, labels map[string]string) (*ConsumerInfo, error) {
 	consumer := openapi.Consumer{
 		Name: &name,
 	}
+	if len(labels) > 0 {
+		consumer.Labels = &labels
+	}
 	created, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersPost(ctx).Consumer(consumer).Execute()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create consumer: %w", err)
@@ -283,6 +742,105 @@ func (c *Client) CreateConsumer(ctx context.Context, name string) (*ConsumerInfo
 	if created.Name != nil {
 		info.Name = *created.Name
 	}
+	if created.Labels != nil {
+		info.Labels = *created.Labels
+	}
+	return info, nil
+}
+
+// ParseLabels parses a comma-separated list of key=value pairs (e.g. "region=us-west,env=prod")
+func ParseLabels(s string) (map[string]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid label %q: expected key=value", pair)
+		}
+		labels[key] = strings.TrimSpace(value)
+	}
+	return labels, nil
+}
+
+// FormatLabels renders labels as sorted key=value pairs joined by commas, the
+// form ParseLabels accepts
+func FormatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+labels[k])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// ParseLabelChanges parses a kubectl-label-style list of changes: key=value
+// pairs to set and "key-" entries to remove (e.g. "env=prod,team-")
+func ParseLabelChanges(s string) (set map[string]string, remove []string, err error) {
+	var pairs []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if key, ok := strings.CutSuffix(item, "-"); ok && !strings.Contains(item, "=") {
+			if key == "" {
+				return nil, nil, fmt.Errorf("invalid label removal %q: expected key-", item)
+			}
+			remove = append(remove, key)
+			continue
+		}
+		pairs = append(pairs, item)
+	}
+	set, err = ParseLabels(strings.Join(pairs, ","))
+	if err != nil {
+		return nil, nil, err
+	}
+	return set, remove, nil
+}
+
+// GetConsumerByName returns the consumer with the given name
+func (c *Client) GetConsumerByName(ctx context.Context, name string) (*ConsumerInfo, error) {
+	consumers, err := c.ListConsumersWithDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range consumers {
+		if consumers[i].Name == name {
+			return &consumers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("consumer %q not found", name)
+}
+
+// UpdateConsumerLabels replaces the labels of the consumer with the given ID.
+// Maestro can only patch a consumer's labels; its name cannot be changed.
+func (c *Client) UpdateConsumerLabels(ctx context.Context, id string, labels map[string]string) (*ConsumerInfo, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	patch := openapi.ConsumerPatchRequest{Labels: &labels}
+	updated, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ConsumersIdPatch(ctx, id).ConsumerPatchRequest(patch).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update consumer labels: %w", err)
+	}
+	info := &ConsumerInfo{}
+	if updated.Id != nil {
+		info.ID = *updated.Id
+	}
+	if updated.Name != nil {
+		info.Name = *updated.Name
+	}
+	if updated.Labels != nil {
+		info.Labels = *updated.Labels
+	}
 	return info, nil
 }
 
@@ -308,6 +866,7 @@ func ResourceBundleToRawMap(rb *openapi.ResourceBundle, consumer string) map[str
 		if name, ok := rb.Metadata["name"].(string); ok {
 			m["name"] = name
 		}
+		m["metadata"] = rb.Metadata
 	}
 	if rb.Version != nil {
 		m["version"] = *rb.Version
@@ -345,6 +904,7 @@ func ResourceBundleToDetails(rb *openapi.ResourceBundle, consumer string) *Manif
 	if details.Name == "" {
 		details.Name = details.ID
 	}
+	details.SourceID = metadataAnnotations(rb.Metadata)[SourceIDAnnotation]
 
 	if rb.Version != nil {
 		details.Version = *rb.Version
@@ -355,6 +915,8 @@ func ResourceBundleToDetails(rb *openapi.ResourceBundle, consumer string) *Manif
 	if rb.UpdatedAt != nil {
 		details.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
 	}
+	details.DeletedAt = bundleDeletedAt(rb)
+	details.raw = ResourceBundleToRawMap(rb, consumer)
 
 	if rb.DeleteOption != nil {
 		if policy, ok := rb.DeleteOption["propagationPolicy"].(string); ok {
@@ -383,79 +945,11 @@ func ResourceBundleToDetails(rb *openapi.ResourceBundle, consumer string) *Manif
 
 	if rb.Status != nil {
 		if conditions, ok := rb.Status["conditions"].([]interface{}); ok {
-			details.Conditions = make([]ConditionSummary, 0, len(conditions))
-			for _, cond := range conditions {
-				if condMap, ok := cond.(map[string]interface{}); ok {
-					cs := ConditionSummary{}
-					if t, ok := condMap["type"].(string); ok {
-						cs.Type = t
-					}
-					if s, ok := condMap["status"].(string); ok {
-						cs.Status = s
-					}
-					if r, ok := condMap["reason"].(string); ok {
-						cs.Reason = r
-					}
-					if m, ok := condMap["message"].(string); ok {
-						cs.Message = m
-					}
-					if lt, ok := condMap["lastTransitionTime"].(string); ok {
-						cs.LastTransitionTime = lt
-					}
-					details.Conditions = append(details.Conditions, cs)
-				}
-			}
+			details.Conditions = parseConditions(conditions)
 		}
 
 		if resourceStatus, ok := rb.Status["resourceStatus"].([]interface{}); ok {
-			details.ResourceStatus = make([]ResourceStatusInfo, 0, len(resourceStatus))
-			for _, rs := range resourceStatus {
-				if rsMap, ok := rs.(map[string]interface{}); ok {
-					rsi := ResourceStatusInfo{}
-					if meta, ok := rsMap["resourceMeta"].(map[string]interface{}); ok {
-						if k, ok := meta["kind"].(string); ok {
-							rsi.Kind = k
-						}
-						if n, ok := meta["name"].(string); ok {
-							rsi.Name = n
-						}
-						if ns, ok := meta["namespace"].(string); ok {
-							rsi.Namespace = ns
-						}
-						if g, ok := meta["group"].(string); ok {
-							rsi.Group = g
-						}
-						if v, ok := meta["version"].(string); ok {
-							rsi.Version = v
-						}
-						if r, ok := meta["resource"].(string); ok {
-							rsi.Resource = r
-						}
-					}
-					if conds, ok := rsMap["conditions"].([]interface{}); ok {
-						rsi.Conditions = make([]ConditionSummary, 0, len(conds))
-						for _, c := range conds {
-							if condMap, ok := c.(map[string]interface{}); ok {
-								cs := ConditionSummary{}
-								if t, ok := condMap["type"].(string); ok {
-									cs.Type = t
-								}
-								if s, ok := condMap["status"].(string); ok {
-									cs.Status = s
-								}
-								if r, ok := condMap["reason"].(string); ok {
-									cs.Reason = r
-								}
-								if m, ok := condMap["message"].(string); ok {
-									cs.Message = m
-								}
-								rsi.Conditions = append(rsi.Conditions, cs)
-							}
-						}
-					}
-					details.ResourceStatus = append(details.ResourceStatus, rsi)
-				}
-			}
+			details.ResourceStatus = parseResourceStatus(resourceStatus)
 		}
 	}
 
@@ -511,15 +1005,13 @@ func (c *Client) ListManifestWorksHTTP(ctx context.Context, consumer string) ([]
 	// Use search parameter to filter by consumer_name
 	search := fmt.Sprintf("consumer_name = '%s'", consumer)
 
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-		Search(search).
-		Execute()
+	items, err := c.listResourceBundles(ctx, search)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list resource bundles: %w", err)
+		return nil, err
 	}
 
-	summaries := make([]ResourceBundleSummary, 0, len(resourceList.Items))
-	for _, rb := range resourceList.Items {
+	summaries := make([]ResourceBundleSummary, 0, len(items))
+	for _, rb := range items {
 		summary := ResourceBundleSummary{
 			ID:           getStringPtr(rb.Id),
 			ConsumerName: consumer,
@@ -535,6 +1027,7 @@ func (c *Client) ListManifestWorksHTTP(ctx context.Context, consumer string) ([]
 		if summary.Name == "" {
 			summary.Name = summary.ID
 		}
+		summary.Labels = metadataLabels(rb.Metadata)
 
 		if rb.Version != nil {
 			summary.Version = *rb.Version
@@ -545,6 +1038,7 @@ func (c *Client) ListManifestWorksHTTP(ctx context.Context, consumer string) ([]
 		if rb.UpdatedAt != nil {
 			summary.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
 		}
+		summary.DeletedAt = bundleDeletedAt(&rb)
 
 		// Extract manifests info (rb.Manifests is []map[string]interface{})
 		if rb.Manifests != nil {
@@ -570,25 +1064,7 @@ func (c *Client) ListManifestWorksHTTP(ctx context.Context, consumer string) ([]
 		// Extract conditions from status
 		if rb.Status != nil {
 			if conditions, ok := rb.Status["conditions"].([]interface{}); ok {
-				summary.Conditions = make([]ConditionSummary, 0, len(conditions))
-				for _, c := range conditions {
-					if cond, ok := c.(map[string]interface{}); ok {
-						cs := ConditionSummary{}
-						if t, ok := cond["type"].(string); ok {
-							cs.Type = t
-						}
-						if s, ok := cond["status"].(string); ok {
-							cs.Status = s
-						}
-						if r, ok := cond["reason"].(string); ok {
-							cs.Reason = r
-						}
-						if m, ok := cond["message"].(string); ok {
-							cs.Message = m
-						}
-						summary.Conditions = append(summary.Conditions, cs)
-					}
-				}
+				summary.Conditions = parseConditions(conditions)
 			}
 		}
 
@@ -598,6 +1074,54 @@ func (c *Client) ListManifestWorksHTTP(ctx context.Context, consumer string) ([]
 	return summaries, nil
 }
 
+// listResourceBundles fetches every resource bundle matching search, one page of
+// c.pageSize at a time.
+func (c *Client) listResourceBundles(ctx context.Context, search string) ([]openapi.ResourceBundle, error) {
+	var items []openapi.ResourceBundle
+	_, err := c.resourceBundlePages(ctx, search, "", func(page []openapi.ResourceBundle) bool {
+		items = append(items, page...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource bundles: %w", err)
+	}
+	return items, nil
+}
+
+// resourceBundlePages passes each page of the resource bundles matching search,
+// c.pageSize at a time, to visit until it returns false or the pages run out.
+// fields asks the server for only those fields; empty returns every field. On
+// error, the response is returned too when there is one.
+func (c *Client) resourceBundlePages(
+	ctx context.Context,
+	search, fields string,
+	visit func(page []openapi.ResourceBundle) bool,
+) (*http.Response, error) {
+	size := c.pageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+
+	seen := int32(0)
+	for page := int32(1); ; page++ {
+		req := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+			Search(search).
+			Page(page).
+			Size(size)
+		if fields != "" {
+			req = req.Fields(fields)
+		}
+		resourceList, resp, err := req.Execute()
+		if err != nil {
+			return resp, err
+		}
+		seen += int32(len(resourceList.Items))
+		if !visit(resourceList.Items) || len(resourceList.Items) == 0 || seen >= resourceList.Total {
+			return nil, nil
+		}
+	}
+}
+
 // GetManifestWorkByNameHTTP looks up a ManifestWork by its original name using HTTP API
 // This reads from the database and doesn't require gRPC subscription
 func (c *Client) GetManifestWorkByNameHTTP(ctx context.Context, consumer, name string) (*ResourceBundleSummary, error) {
@@ -605,64 +1129,48 @@ func (c *Client) GetManifestWorkByNameHTTP(ctx context.Context, consumer, name s
 		return nil, fmt.Errorf("invalid consumer name: %w", err)
 	}
 
-	// Search for resource bundle by consumer and metadata name
-	search := fmt.Sprintf("consumer_name = '%s'", consumer)
-
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-		Search(search).
-		Execute()
+	// Search every page of the consumer's resource bundles for the metadata name
+	rb, err := c.findResourceBundleHTTP(ctx, consumer, name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search resource bundles: %w", err)
+		return nil, err
 	}
-
-	// Find the one with matching metadata.name
-	for _, rb := range resourceList.Items {
-		var rbName string
-		if rb.Metadata != nil {
-			if n, ok := rb.Metadata["name"].(string); ok {
-				rbName = n
-			}
-		}
-		if rbName == name {
-			summary := &ResourceBundleSummary{
-				ID:           getStringPtr(rb.Id),
-				Name:         rbName,
-				ConsumerName: consumer,
-			}
-			if rb.Version != nil {
-				summary.Version = *rb.Version
-			}
-			if rb.CreatedAt != nil {
-				summary.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
-			}
-			if rb.UpdatedAt != nil {
-				summary.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	summary := &ResourceBundleSummary{
+		ID:           getStringPtr(rb.Id),
+		Name:         name,
+		ConsumerName: consumer,
+		Labels:       metadataLabels(rb.Metadata),
+	}
+	if rb.Version != nil {
+		summary.Version = *rb.Version
+	}
+	if rb.CreatedAt != nil {
+		summary.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
+	}
+	if rb.UpdatedAt != nil {
+		summary.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	}
+	summary.DeletedAt = bundleDeletedAt(rb)
+	// Extract manifests
+	if rb.Manifests != nil {
+		summary.Manifests = make([]ManifestInfo, 0, len(rb.Manifests))
+		for _, manifest := range rb.Manifests {
+			info := ManifestInfo{}
+			if kind, ok := manifest["kind"].(string); ok {
+				info.Kind = kind
 			}
-			// Extract manifests
-			if rb.Manifests != nil {
-				summary.Manifests = make([]ManifestInfo, 0, len(rb.Manifests))
-				for _, manifest := range rb.Manifests {
-					info := ManifestInfo{}
-					if kind, ok := manifest["kind"].(string); ok {
-						info.Kind = kind
-					}
-					if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
-						if n, ok := metadata["name"].(string); ok {
-							info.Name = n
-						}
-						if ns, ok := metadata["namespace"].(string); ok {
-							info.Namespace = ns
-						}
-					}
-					summary.Manifests = append(summary.Manifests, info)
+			if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
+				if n, ok := metadata["name"].(string); ok {
+					info.Name = n
+				}
+				if ns, ok := metadata["namespace"].(string); ok {
+					info.Namespace = ns
 				}
-				summary.ManifestCount = len(summary.Manifests)
 			}
-			return summary, nil
+			summary.Manifests = append(summary.Manifests, info)
 		}
+		summary.ManifestCount = len(summary.Manifests)
 	}
-
-	return nil, errors.NewNotFound(workv1.Resource("manifestwork"), name)
+	return summary, nil
 }
 
 // GetManifestWorkDetailsHTTP gets full details of a ManifestWork by name using HTTP API
@@ -671,195 +1179,92 @@ func (c *Client) GetManifestWorkDetailsHTTP(ctx context.Context, consumer, name
 		return nil, fmt.Errorf("invalid consumer name: %w", err)
 	}
 
-	// Search for resource bundle by consumer
-	search := fmt.Sprintf("consumer_name = '%s'", consumer)
-
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-		Search(search).
-		Execute()
+	// Search every page of the consumer's resource bundles for the metadata name
+	rb, err := c.findResourceBundleHTTP(ctx, consumer, name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search resource bundles: %w", err)
+		return nil, err
 	}
 
-	// Find the one with matching metadata.name
-	for _, rb := range resourceList.Items {
-		var rbName string
-		if rb.Metadata != nil {
-			if n, ok := rb.Metadata["name"].(string); ok {
-				rbName = n
-			}
-		}
-		if rbName != name {
-			continue
-		}
-
-		details := &ManifestWorkDetails{
-			ID:           getStringPtr(rb.Id),
-			Name:         rbName,
-			ConsumerName: consumer,
-		}
-
-		if rb.Version != nil {
-			details.Version = *rb.Version
-		}
-		if rb.CreatedAt != nil {
-			details.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
-		}
-		if rb.UpdatedAt != nil {
-			details.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
-		}
-
-		// Extract delete option
-		if rb.DeleteOption != nil {
-			if policy, ok := rb.DeleteOption["propagationPolicy"].(string); ok {
-				details.DeleteOption = policy
-			}
-		}
-
-		// Extract manifests
-		if rb.Manifests != nil {
-			details.Manifests = make([]ManifestInfo, 0, len(rb.Manifests))
-			for _, manifest := range rb.Manifests {
-				info := ManifestInfo{}
-				if kind, ok := manifest["kind"].(string); ok {
-					info.Kind = kind
-				}
-				if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
-					if n, ok := metadata["name"].(string); ok {
-						info.Name = n
-					}
-					if ns, ok := metadata["namespace"].(string); ok {
-						info.Namespace = ns
-					}
-				}
-				details.Manifests = append(details.Manifests, info)
-			}
-		}
-
-		// Extract conditions from status
-		if rb.Status != nil {
-			if conditions, ok := rb.Status["conditions"].([]interface{}); ok {
-				details.Conditions = make([]ConditionSummary, 0, len(conditions))
-				for _, c := range conditions {
-					if cond, ok := c.(map[string]interface{}); ok {
-						cs := ConditionSummary{}
-						if t, ok := cond["type"].(string); ok {
-							cs.Type = t
-						}
-						if s, ok := cond["status"].(string); ok {
-							cs.Status = s
-						}
-						if r, ok := cond["reason"].(string); ok {
-							cs.Reason = r
-						}
-						if m, ok := cond["message"].(string); ok {
-							cs.Message = m
-						}
-						if lt, ok := cond["lastTransitionTime"].(string); ok {
-							cs.LastTransitionTime = lt
-						}
-						details.Conditions = append(details.Conditions, cs)
-					}
-				}
-			}
-
-			// Extract resource status
-			if resourceStatus, ok := rb.Status["resourceStatus"].([]interface{}); ok {
-				details.ResourceStatus = make([]ResourceStatusInfo, 0, len(resourceStatus))
-				for _, rs := range resourceStatus {
-					if rsMap, ok := rs.(map[string]interface{}); ok {
-						rsi := ResourceStatusInfo{}
-
-						// Extract resource meta
-						if meta, ok := rsMap["resourceMeta"].(map[string]interface{}); ok {
-							if k, ok := meta["kind"].(string); ok {
-								rsi.Kind = k
-							}
-							if n, ok := meta["name"].(string); ok {
-								rsi.Name = n
-							}
-							if ns, ok := meta["namespace"].(string); ok {
-								rsi.Namespace = ns
-							}
-							if g, ok := meta["group"].(string); ok {
-								rsi.Group = g
-							}
-							if v, ok := meta["version"].(string); ok {
-								rsi.Version = v
-							}
-							if r, ok := meta["resource"].(string); ok {
-								rsi.Resource = r
-							}
-						}
+	details := &ManifestWorkDetails{
+		ID:           getStringPtr(rb.Id),
+		Name:         name,
+		ConsumerName: consumer,
+		SourceID:     metadataAnnotations(rb.Metadata)[SourceIDAnnotation],
+	}
 
-						// Extract conditions
-						if conds, ok := rsMap["conditions"].([]interface{}); ok {
-							rsi.Conditions = make([]ConditionSummary, 0, len(conds))
-							for _, c := range conds {
-								if condMap, ok := c.(map[string]interface{}); ok {
-									cs := ConditionSummary{}
-									if t, ok := condMap["type"].(string); ok {
-										cs.Type = t
-									}
-									if s, ok := condMap["status"].(string); ok {
-										cs.Status = s
-									}
-									if r, ok := condMap["reason"].(string); ok {
-										cs.Reason = r
-									}
-									if m, ok := condMap["message"].(string); ok {
-										cs.Message = m
-									}
-									rsi.Conditions = append(rsi.Conditions, cs)
-								}
-							}
-						}
+	if rb.Version != nil {
+		details.Version = *rb.Version
+	}
+	if rb.CreatedAt != nil {
+		details.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
+	}
+	if rb.UpdatedAt != nil {
+		details.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	}
+	details.DeletedAt = bundleDeletedAt(rb)
+	details.raw = ResourceBundleToRawMap(rb, consumer)
 
-						// Extract status feedback
-						if feedback, ok := rsMap["statusFeedback"].(map[string]interface{}); ok {
-							if values, ok := feedback["values"].([]interface{}); ok && len(values) > 0 {
-								rsi.StatusFeedback = make(map[string]interface{})
-								for _, v := range values {
-									if valMap, ok := v.(map[string]interface{}); ok {
-										if name, ok := valMap["name"].(string); ok {
-											if fv, ok := valMap["fieldValue"].(map[string]interface{}); ok {
-												if strVal, ok := fv["string"].(string); ok {
-													rsi.StatusFeedback[name] = strVal
-												} else if intVal, ok := fv["integer"].(float64); ok {
-													rsi.StatusFeedback[name] = int64(intVal)
-												} else if boolVal, ok := fv["boolean"].(bool); ok {
-													rsi.StatusFeedback[name] = boolVal
-												} else if jsonRaw, ok := fv["jsonRaw"].(string); ok {
-													// Parse JSON raw string into interface{}
-													var parsed interface{}
-													if err := json.Unmarshal([]byte(jsonRaw), &parsed); err == nil {
-														rsi.StatusFeedback[name] = parsed
-													} else {
-														// If parsing fails, store as raw string
-														rsi.StatusFeedback[name] = jsonRaw
-													}
-												}
-											}
-										}
-									}
-								}
-							}
-						}
+	// Extract delete option
+	if rb.DeleteOption != nil {
+		if policy, ok := rb.DeleteOption["propagationPolicy"].(string); ok {
+			details.DeleteOption = policy
+		}
+	}
 
-						details.ResourceStatus = append(details.ResourceStatus, rsi)
-					}
+	// Extract manifests
+	if rb.Manifests != nil {
+		details.Manifests = make([]ManifestInfo, 0, len(rb.Manifests))
+		for _, manifest := range rb.Manifests {
+			info := ManifestInfo{}
+			if kind, ok := manifest["kind"].(string); ok {
+				info.Kind = kind
+			}
+			if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
+				if n, ok := metadata["name"].(string); ok {
+					info.Name = n
+				}
+				if ns, ok := metadata["namespace"].(string); ok {
+					info.Namespace = ns
 				}
 			}
+			details.Manifests = append(details.Manifests, info)
+		}
+	}
+
+	// Extract conditions from status
+	if rb.Status != nil {
+		if conditions, ok := rb.Status["conditions"].([]interface{}); ok {
+			details.Conditions = parseConditions(conditions)
 		}
 
-		return details, nil
+		// Extract resource status
+		if resourceStatus, ok := rb.Status["resourceStatus"].([]interface{}); ok {
+			details.ResourceStatus = parseResourceStatus(resourceStatus)
+		}
 	}
 
-	return nil, errors.NewNotFound(workv1.Resource("manifestwork"), name)
+	return details, nil
 }
 
 // DeleteResourceBundleByID deletes a resource bundle directly by its ID
-func (c *Client) DeleteResourceBundleByID(ctx context.Context, id string) error {
+// If expectedVersion is non-zero the bundle's current version is checked first and
+// a Conflict error is returned when it differs, so a concurrent change is not
+// silently discarded.
+func (c *Client) DeleteResourceBundleByID(ctx context.Context, id string, expectedVersion int32) error {
+	if expectedVersion != 0 {
+		rb, err := c.GetResourceBundleHTTP(ctx, id)
+		if err != nil {
+			return err
+		}
+		name := getStringPtr(rb.Name)
+		if name == "" {
+			name = id
+		}
+		if err := checkVersion(name, expectedVersion, rb.GetVersion()); err != nil {
+			return err
+		}
+	}
+
 	_, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesIdDelete(ctx, id).Execute()
 	if err != nil {
 		return fmt.Errorf("failed to delete resource bundle %s: %w", id, err)
@@ -885,6 +1290,95 @@ func (c *Client) DeleteManifestWorkByNameHTTP(ctx context.Context, consumer, nam
 	return nil
 }
 
+// checkVersion returns a Conflict error when the version read before a change
+// no longer matches the server's. The REST API has no If-Match support, so the
+// precondition is checked from the client just before the write.
+func checkVersion(name string, expected, actual int32) error {
+	if expected == actual {
+		return nil
+	}
+	return errors.NewConflict(workv1.Resource("manifestwork"), name, fmt.Errorf(
+		"object was modified (version %d, expected %d), re-fetch and retry", actual, expected))
+}
+
+// metadataLabels extracts the string-valued labels from resource bundle metadata
+func metadataLabels(metadata map[string]interface{}) map[string]string {
+	return metadataStringMap(metadata, "labels")
+}
+
+// metadataAnnotations extracts the string-valued annotations from resource bundle metadata
+// bundleDeletedAt returns when deletion of rb was requested: the server's
+// deleted_at, or else the metadata deletionTimestamp. Empty when it is not
+// being deleted.
+func bundleDeletedAt(rb *openapi.ResourceBundle) string {
+	if rb.DeletedAt != nil && !rb.DeletedAt.IsZero() {
+		return rb.DeletedAt.Format(time.RFC3339)
+	}
+	ts, _ := rb.Metadata["deletionTimestamp"].(string)
+	return ts
+}
+
+// IsTerminating reports whether a ManifestWork is mid-deletion: deletion has
+// been requested (deletedAt is set) or the agent reports a Terminating or
+// Deleted condition.
+func IsTerminating(deletedAt string, conditions []ConditionSummary) bool {
+	if deletedAt != "" {
+		return true
+	}
+	for _, c := range conditions {
+		if c.Status != statusTrue {
+			continue
+		}
+		if strings.EqualFold(c.Type, conditionTerminating) || strings.EqualFold(c.Type, conditionDeleted) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAppliedAndAvailable reports whether conditions hold both Applied and
+// Available with status True.
+func IsAppliedAndAvailable(conditions []ConditionSummary) bool {
+	applied, available := false, false
+	for _, c := range conditions {
+		if c.Status != statusTrue {
+			continue
+		}
+		switch c.Type {
+		case statusApplied:
+			applied = true
+		case "Available":
+			available = true
+		}
+	}
+	return applied && available
+}
+
+// IsFailing reports whether a ManifestWork has reported conditions without
+// being both Applied and Available. Works being deleted and works the agent
+// has not reported on yet are not failing.
+func IsFailing(deletedAt string, conditions []ConditionSummary) bool {
+	return !IsTerminating(deletedAt, conditions) && len(conditions) > 0 && !IsAppliedAndAvailable(conditions)
+}
+
+func metadataAnnotations(metadata map[string]interface{}) map[string]string {
+	return metadataStringMap(metadata, "annotations")
+}
+
+func metadataStringMap(metadata map[string]interface{}, key string) map[string]string {
+	raw, ok := metadata[key].(map[string]interface{})
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(raw))
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			out[k] = s
+		}
+	}
+	return out
+}
+
 // getStringPtr safely dereferences a string pointer
 func getStringPtr(s *string) string {
 	if s == nil {
@@ -908,6 +1402,18 @@ func (m ManifestInfo) String() string {
 	return fmt.Sprintf("%s/%s", m.Kind, m.Name)
 }
 
+// ManifestKinds returns the distinct kinds of manifests in the order they
+// first appear, e.g. [Job ConfigMap]
+func ManifestKinds(manifests []ManifestInfo) []string {
+	var kinds []string
+	for _, m := range manifests {
+		if m.Kind != "" && !slices.Contains(kinds, m.Kind) {
+			kinds = append(kinds, m.Kind)
+		}
+	}
+	return kinds
+}
+
 // ConditionSummary represents a condition status
 type ConditionSummary struct {
 	Type               string `json:"type" yaml:"type"`
@@ -915,6 +1421,7 @@ type ConditionSummary struct {
 	Reason             string `json:"reason,omitempty" yaml:"reason,omitempty"`
 	Message            string `json:"message,omitempty" yaml:"message,omitempty"`
 	LastTransitionTime string `json:"lastTransitionTime,omitempty" yaml:"lastTransitionTime,omitempty"`
+	ObservedGeneration int64  `json:"observedGeneration,omitempty" yaml:"observedGeneration,omitempty"`
 }
 
 // ResourceStatusInfo represents the status of a specific resource in the ManifestWork
@@ -929,6 +1436,24 @@ type ResourceStatusInfo struct {
 	StatusFeedback map[string]interface{} `json:"statusFeedback,omitempty" yaml:"statusFeedback,omitempty"`
 }
 
+// DisplayName returns "kind/name" for display, with placeholders for the
+// parts the agent did not report. Custom resources often come back with only
+// the resource (plural) set, so that is used when the kind is missing.
+func (r ResourceStatusInfo) DisplayName() string {
+	kind := r.Kind
+	if kind == "" {
+		kind = r.Resource
+	}
+	if kind == "" {
+		kind = "Unknown"
+	}
+	name := r.Name
+	if name == "" {
+		name = "(unnamed)"
+	}
+	return kind + "/" + name
+}
+
 // ManifestWorkDetails contains full details of a ManifestWork
 type ManifestWorkDetails struct {
 	ID             string               `json:"id" yaml:"id"`
@@ -937,10 +1462,15 @@ type ManifestWorkDetails struct {
 	Version        int32                `json:"version" yaml:"version"`
 	CreatedAt      string               `json:"createdAt" yaml:"createdAt"`
 	UpdatedAt      string               `json:"updatedAt" yaml:"updatedAt"`
+	DeletedAt      string               `json:"deletedAt,omitempty" yaml:"deletedAt,omitempty"`
 	Manifests      []ManifestInfo       `json:"manifests" yaml:"manifests"`
 	Conditions     []ConditionSummary   `json:"conditions" yaml:"conditions"`
 	ResourceStatus []ResourceStatusInfo `json:"resourceStatus,omitempty" yaml:"resourceStatus,omitempty"`
 	DeleteOption   string               `json:"deleteOption,omitempty" yaml:"deleteOption,omitempty"`
+	SourceID       string               `json:"sourceId,omitempty" yaml:"sourceId,omitempty"`
+
+	// raw is the bundle as ResourceBundleToRawMap returns it, for {jsonpath} conditions
+	raw map[string]interface{}
 }
 
 // ResourceBundleSummary represents a summary of a resource bundle from HTTP API
@@ -951,7 +1481,9 @@ type ResourceBundleSummary struct {
 	Version       int32              `json:"version" yaml:"version"`
 	CreatedAt     string             `json:"createdAt" yaml:"createdAt"`
 	UpdatedAt     string             `json:"updatedAt" yaml:"updatedAt"`
+	DeletedAt     string             `json:"deletedAt,omitempty" yaml:"deletedAt,omitempty"`
 	ManifestCount int                `json:"manifestCount" yaml:"manifestCount"`
+	Labels        map[string]string  `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Manifests     []ManifestInfo     `json:"manifests" yaml:"manifests"`
 	Conditions    []ConditionSummary `json:"conditions,omitempty" yaml:"conditions,omitempty"`
 }
@@ -965,6 +1497,20 @@ func (c *Client) GetResourceBundleHTTP(ctx context.Context, id string) (*openapi
 	return resource, nil
 }
 
+// GetResourceBundleDetailsHTTP gets a single resource bundle by ID as details,
+// together with the raw map ResourceBundleToRawMap builds from it. consumer
+// fills in the consumer name, which the bundle itself does not carry.
+func (c *Client) GetResourceBundleDetailsHTTP(
+	ctx context.Context,
+	id, consumer string,
+) (*ManifestWorkDetails, map[string]interface{}, error) {
+	rb, err := c.GetResourceBundleHTTP(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ResourceBundleToDetails(rb, consumer), ResourceBundleToRawMap(rb, consumer), nil
+}
+
 // GetResourceBundleByNameHTTP gets a resource bundle by name and consumer using the HTTP API
 func (c *Client) GetResourceBundleByNameHTTP(
 	ctx context.Context,
@@ -1008,8 +1554,18 @@ type ResourceBundleFull struct {
 	Status       map[string]interface{}   `json:"status,omitempty" yaml:"status,omitempty"`
 }
 
-// GetResourceBundleFullHTTP gets a full resource bundle by name and consumer for output
-func (c *Client) GetResourceBundleFullHTTP(ctx context.Context, consumer, name string) (*ResourceBundleFull, error) {
+// findResourceBundleHTTP returns the consumer's resource bundle whose metadata.name is name,
+// searching every page of the consumer's resource bundles
+func (c *Client) findResourceBundleHTTP(ctx context.Context, consumer, name string) (*openapi.ResourceBundle, error) {
+	return c.findResourceBundleFieldsHTTP(ctx, consumer, name, "")
+}
+
+// findResourceBundleFieldsHTTP is findResourceBundleHTTP asking the server to
+// return only fields, a comma-separated list; empty returns every field.
+func (c *Client) findResourceBundleFieldsHTTP(
+	ctx context.Context,
+	consumer, name, fields string,
+) (*openapi.ResourceBundle, error) {
 	if err := validateSearchQuery(consumer); err != nil {
 		return nil, fmt.Errorf("invalid consumer name: %w", err)
 	}
@@ -1017,54 +1573,120 @@ func (c *Client) GetResourceBundleFullHTTP(ctx context.Context, consumer, name s
 	// Search for resource bundle by consumer
 	search := fmt.Sprintf("consumer_name = '%s'", consumer)
 
-	resourceList, _, err := c.httpClient.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-		Search(search).
-		Execute()
+	// Find the one with matching metadata.name, stopping at the page it is on
+	var found *openapi.ResourceBundle
+	resp, err := c.resourceBundlePages(ctx, search, fields, func(page []openapi.ResourceBundle) bool {
+		for i := range page {
+			if n, ok := page[i].Metadata["name"].(string); ok && n == name {
+				found = &page[i]
+				return false
+			}
+		}
+		return true
+	})
 	if err != nil {
+		if fields != "" && resp != nil && resp.StatusCode == http.StatusBadRequest {
+			return nil, fmt.Errorf("%w: %w", errFieldsUnsupported, err)
+		}
 		return nil, fmt.Errorf("failed to search resource bundles: %w", err)
 	}
+	if found == nil {
+		return nil, errors.NewNotFound(workv1.Resource("manifestwork"), name)
+	}
+	return found, nil
+}
 
-	// Find the one with matching metadata.name
-	for _, rb := range resourceList.Items {
-		var rbName string
-		if rb.Metadata != nil {
-			if n, ok := rb.Metadata["name"].(string); ok {
-				rbName = n
-			}
-		}
-		if rbName != name {
-			continue
-		}
+// GetResourceBundleFullHTTP gets a full resource bundle by name and consumer for output
+func (c *Client) GetResourceBundleFullHTTP(ctx context.Context, consumer, name string) (*ResourceBundleFull, error) {
+	rb, err := c.findResourceBundleHTTP(ctx, consumer, name)
+	if err != nil {
+		return nil, err
+	}
+	return resourceBundleFull(rb, consumer, name), nil
+}
 
-		result := &ResourceBundleFull{
-			ID:           getStringPtr(rb.Id),
-			Name:         rbName,
-			ConsumerName: consumer,
-		}
+// resourceBundleFull converts rb, found by name in consumer, for output
+func resourceBundleFull(rb *openapi.ResourceBundle, consumer, name string) *ResourceBundleFull {
+	result := &ResourceBundleFull{
+		ID:           getStringPtr(rb.Id),
+		Name:         name,
+		ConsumerName: consumer,
+	}
 
-		if rb.Version != nil {
-			result.Version = *rb.Version
-		}
-		if rb.CreatedAt != nil {
-			result.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
-		}
-		if rb.UpdatedAt != nil {
-			result.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
-		}
-		if rb.DeleteOption != nil {
-			result.DeleteOption = rb.DeleteOption
-		}
-		if rb.Manifests != nil {
-			result.Manifests = rb.Manifests
-		}
-		if rb.Status != nil {
-			result.Status = rb.Status
-		}
+	if rb.Version != nil {
+		result.Version = *rb.Version
+	}
+	if rb.CreatedAt != nil {
+		result.CreatedAt = rb.CreatedAt.Format(time.RFC3339)
+	}
+	if rb.UpdatedAt != nil {
+		result.UpdatedAt = rb.UpdatedAt.Format(time.RFC3339)
+	}
+	if rb.DeleteOption != nil {
+		result.DeleteOption = rb.DeleteOption
+	}
+	if rb.Manifests != nil {
+		result.Manifests = rb.Manifests
+	}
+	if rb.Status != nil {
+		result.Status = rb.Status
+	}
+
+	return result
+}
 
-		return result, nil
+// SubresourceStatus is the get --subresource value that fetches only the
+// status of a resource bundle
+const SubresourceStatus = "status"
+
+// statusFields are the resource bundle fields requested for SubresourceStatus.
+// metadata carries the name the bundle is looked up by.
+const statusFields = "id,name,consumer_name,version,created_at,updated_at,metadata,status"
+
+// errFieldsUnsupported reports a server refusing the fields query parameter
+var errFieldsUnsupported = stderrors.New("server does not support selecting resource bundle fields")
+
+// ValidateSubresource checks a get --subresource value; empty fetches the
+// whole resource bundle.
+func ValidateSubresource(subresource string) error {
+	if subresource != "" && subresource != SubresourceStatus {
+		return fmt.Errorf("unsupported subresource %q (supported: %s)", subresource, SubresourceStatus)
+	}
+	return nil
+}
+
+// GetResourceBundleStatusHTTP gets a resource bundle by name and consumer
+// without its manifests and delete option. The server is asked for only the
+// status and identifying fields, which keeps the response small for large
+// bundles; a server that rejects the field selection is logged and the full
+// bundle is fetched and trimmed instead.
+func (c *Client) GetResourceBundleStatusHTTP(
+	ctx context.Context,
+	consumer, name string,
+	log *logger.Logger,
+) (*ResourceBundleFull, error) {
+	rb, err := c.findResourceBundleFieldsHTTP(ctx, consumer, name, statusFields)
+	if stderrors.Is(err, errFieldsUnsupported) {
+		log.Warn(ctx, "Server cannot return only the status, fetching the whole resource bundle",
+			logger.Fields{"error": err.Error()})
+		rb, err = c.findResourceBundleHTTP(ctx, consumer, name)
+	}
+	if err != nil {
+		return nil, err
 	}
+	result := resourceBundleFull(rb, consumer, name)
+	result.Manifests, result.DeleteOption = nil, nil
+	return result, nil
+}
 
-	return nil, errors.NewNotFound(workv1.Resource("manifestwork"), name)
+// GetResourceBundleRawHTTP gets a resource bundle by name and consumer as the
+// plain map built by ResourceBundleToRawMap
+func (c *Client) GetResourceBundleRawHTTP(ctx context.Context, consumer, name string) (map[string]interface{}, error) {
+	rb, err := c.findResourceBundleHTTP(ctx, consumer, name)
+	if err != nil {
+		return nil, err
+	}
+	return ResourceBundleToRawMap(rb, consumer), nil
 }
 
 // DeleteManifestWork deletes a ManifestWork from the target consumer
@@ -1132,10 +1754,17 @@ func (c *Client) ManifestWorkExists(ctx context.Context, consumer, name string)
 }
 
 // ApplyManifestWork applies a ManifestWork to the target consumer
+//
+// When manifestWork sets metadata.resourceVersion, an update only goes ahead if
+// the ManifestWork on the server still has that version; otherwise a Conflict
+// error is returned. force skips that check. Without a resourceVersion there is
+// nothing to check against: the REST API has no If-Match support and the patch
+// carries no precondition, so the update is last-writer-wins.
 func (c *Client) ApplyManifestWork(
 	ctx context.Context,
 	consumer string,
 	manifestWork *workv1.ManifestWork,
+	force bool,
 	log *logger.Logger,
 ) (*workv1.ManifestWork, error) {
 	if c.workClient == nil {
@@ -1145,6 +1774,14 @@ func (c *Client) ApplyManifestWork(
 	// Set the namespace to the consumer name (this is how Maestro routing works)
 	manifestWork.Namespace = consumer
 
+	// Record which source applied the work so it can be attributed later
+	if c.sourceID != "" {
+		if manifestWork.Annotations == nil {
+			manifestWork.Annotations = map[string]string{}
+		}
+		manifestWork.Annotations[SourceIDAnnotation] = c.sourceID
+	}
+
 	// Check if ManifestWork exists using HTTP API (reliable, reads from DB)
 	existingSummary, err := c.GetManifestWorkByNameHTTP(ctx, consumer, manifestWork.Name)
 	if err != nil && !errors.IsNotFound(err) {
@@ -1180,6 +1817,12 @@ func (c *Client) ApplyManifestWork(
 		"current_generation":       existingWork.Generation,
 	})
 
+	if rv, err := strconv.ParseInt(manifestWork.ResourceVersion, 10, 32); err == nil && rv > 0 && !force {
+		if err := checkVersion(manifestWork.Name, int32(rv), existingSummary.Version); err != nil {
+			return nil, err
+		}
+	}
+
 	patchData, err := grpcsource.ToWorkPatch(existingWork, manifestWork)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create patch: %w", err)
@@ -1196,6 +1839,7 @@ type WaitCallback func(details *ManifestWorkDetails, conditionMet bool) error
 // WaitForCondition polls for a ManifestWork condition expression using HTTP API
 // Supports logical expressions like "Available AND Job:Complete" or "Job:succeeded>=1 OR Job:Failed"
 // The optional callback is invoked on each poll to report progress
+// If the context ends first, the error is a *ConditionNotMetError carrying the last polled details
 func (c *Client) WaitForCondition(
 	ctx context.Context,
 	consumer, workName, conditionExpr string,
@@ -1239,6 +1883,10 @@ func (c *Client) WaitForCondition(
 	ticker := time.NewTicker(pollInterval)
 	defer ticker.Stop()
 
+	deadline, hasDeadline := ctx.Deadline()
+	lastProgress := time.Now()
+	last := details
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -1246,7 +1894,7 @@ func (c *Client) WaitForCondition(
 				"condition": conditionExpr,
 				"error":     ctx.Err().Error(),
 			})
-			return ctx.Err()
+			return &ConditionNotMetError{Condition: conditionExpr, Details: last, Err: ctx.Err()}
 		case <-ticker.C:
 			details, err := c.GetManifestWorkDetailsHTTP(ctx, consumer, workName)
 			if err != nil {
@@ -1255,6 +1903,7 @@ func (c *Client) WaitForCondition(
 				})
 				continue
 			}
+			last = details
 
 			conditionMet := evaluateConditionExpression(ctx, details, conditionExpr, log)
 
@@ -1277,6 +1926,16 @@ func (c *Client) WaitForCondition(
 				})
 				return nil
 			}
+
+			// Periodically reassure the operator that the wait is alive and bounded
+			if hasDeadline && time.Since(lastProgress) >= WaitProgressInterval {
+				lastProgress = time.Now()
+				log.Debug(ctx, "Still waiting for condition", logger.Fields{
+					"condition": conditionExpr,
+					"name":      workName,
+					"remaining": time.Until(deadline).Round(time.Second).String(),
+				})
+			}
 		}
 	}
 }
@@ -1329,8 +1988,11 @@ func (c *Client) WaitForDeletion(
 // Supports:
 //   - ManifestWork conditions: "Available", "Applied"
 //   - StatusFeedback conditions: "Job:Complete", "Job:succeeded>=1"
+//   - JSONPath predicates on the resource bundle: "{.status.readyReplicas}>=3", "{.metadata.labels.ready}"
 //   - Logical operators: "AND", "OR", "&&", "||"
 //   - Parentheses for grouping: "(A AND B) OR C"
+//
+// OR binds loosest, then AND, so "A OR B AND C" means "A OR (B AND C)".
 func evaluateConditionExpression(
 	ctx context.Context,
 	details *ManifestWorkDetails,
@@ -1342,128 +2004,110 @@ func evaluateConditionExpression(
 		return false
 	}
 
-	// Handle parentheses - find matching pairs
-	if strings.HasPrefix(expr, "(") {
-		depth := 0
-		for i, ch := range expr {
-			if ch == '(' {
-				depth++
-			} else if ch == ')' {
-				depth--
-				if depth == 0 {
-					// Found matching closing paren
-					inner := expr[1:i]
-					rest := strings.TrimSpace(expr[i+1:])
-					innerResult := evaluateConditionExpression(ctx, details, inner, log)
-
-					if rest == "" {
-						return innerResult
-					}
-
-					// Check for operator after parentheses
-					if strings.HasPrefix(rest, "AND") || strings.HasPrefix(rest, "&&") {
-						if strings.HasPrefix(rest, "&&") {
-							rest = strings.TrimPrefix(rest, "&&")
-						} else {
-							rest = strings.TrimPrefix(rest, "AND")
-						}
-						return innerResult && evaluateConditionExpression(ctx, details, strings.TrimSpace(rest), log)
-					}
-					if strings.HasPrefix(rest, "OR") || strings.HasPrefix(rest, "||") {
-						if strings.HasPrefix(rest, "||") {
-							rest = strings.TrimPrefix(rest, "||")
-						} else {
-							rest = strings.TrimPrefix(rest, "OR")
-						}
-						return innerResult || evaluateConditionExpression(ctx, details, strings.TrimSpace(rest), log)
-					}
-					break
-				}
+	// Check for OR (lowest precedence, split first)
+	if orParts := splitByOperator(expr, "OR", "||"); len(orParts) > 1 {
+		for _, part := range orParts {
+			if evaluateConditionExpression(ctx, details, part, log) {
+				return true
 			}
 		}
+		return false
 	}
 
-	// Check for AND (higher precedence, evaluated first to split)
-	andParts := splitByOperator(expr, "AND", "&&")
-	if len(andParts) > 1 {
+	// Check for AND
+	if andParts := splitByOperator(expr, "AND", "&&"); len(andParts) > 1 {
 		for _, part := range andParts {
-			if !evaluateConditionExpression(ctx, details, strings.TrimSpace(part), log) {
+			if !evaluateConditionExpression(ctx, details, part, log) {
 				return false
 			}
 		}
 		return true
 	}
 
-	// Check for OR (lower precedence)
-	orParts := splitByOperator(expr, "OR", "||")
-	if len(orParts) > 1 {
-		for _, part := range orParts {
-			if evaluateConditionExpression(ctx, details, strings.TrimSpace(part), log) {
-				return true
-			}
-		}
-		return false
+	// A fully parenthesized group
+	if inner, ok := stripOuterParens(expr); ok {
+		return evaluateConditionExpression(ctx, details, inner, log)
 	}
 
 	// Single condition - evaluate it
 	return evaluateSingleCondition(ctx, details, expr, log)
 }
 
-// splitByOperator splits expression by operator, respecting parentheses
+// splitByOperator splits expr on a top-level operator, given as a word (op1,
+// e.g. "AND") or a symbol (op2, e.g. "&&"). Operators inside parentheses or
+// inside a {jsonpath} do not split; empty parts are dropped.
 func splitByOperator(expr, op1, op2 string) []string {
 	var parts []string
-	var current strings.Builder
-	depth := 0
+	start, depth, braces := 0, 0, 0
+	flush := func(end int) {
+		if part := strings.TrimSpace(expr[start:end]); part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	for i := 0; i < len(expr); i++ {
+		switch ch := expr[i]; {
+		case ch == '{':
+			braces++
+		case ch == '}' && braces > 0:
+			braces--
+		case braces > 0:
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+		case depth != 0:
+		case op2 != "" && strings.HasPrefix(expr[i:], op2):
+			flush(i)
+			i += len(op2) - 1
+			start = i + 1
+		case strings.HasPrefix(expr[i:], op1) && isOperatorBoundary(expr, i-1) && isOperatorBoundary(expr, i+len(op1)):
+			flush(i)
+			i += len(op1) - 1
+			start = i + 1
+		}
+	}
+	flush(len(expr))
 
-	words := strings.Fields(expr)
-	for _, word := range words {
-		if word == "(" || strings.HasPrefix(word, "(") {
-			depth += strings.Count(word, "(") - strings.Count(word, ")")
-		} else if word == ")" || strings.HasSuffix(word, ")") {
-			depth += strings.Count(word, "(") - strings.Count(word, ")")
-		}
-
-		if depth == 0 && (word == op1 || word == op2) {
-			if current.Len() > 0 {
-				parts = append(parts, current.String())
-				current.Reset()
-			}
-			continue
-		}
+	return parts
+}
 
-		if current.Len() > 0 {
-			current.WriteString(" ")
-		}
-		current.WriteString(word)
+// isOperatorBoundary reports whether expr[i] can border a word operator
+func isOperatorBoundary(expr string, i int) bool {
+	if i < 0 || i >= len(expr) {
+		return true
+	}
+	switch expr[i] {
+	case ' ', '\t', '(', ')':
+		return true
+	}
+	return false
+}
 
-		// Handle inline operators like "A&&B" or "A||B"
-		if depth == 0 {
-			if strings.Contains(word, op2) && op2 != "" {
-				// Split the current content by the inline operator
-				content := current.String()
-				subParts := strings.Split(content, op2)
-				// Add the first part
-				if subParts[0] != "" {
-					parts = append(parts, subParts[0])
-				}
-				// Add remaining parts separated by the operator
-				for _, part := range subParts[1:] {
-					if part != "" {
-						parts = append(parts, part)
-					}
-				}
-				// Reset current since we've processed the entire content
-				current.Reset()
-				continue
+// stripOuterParens returns expr without the parentheses around it, if the
+// first one closes at the very end (so "(A) OR (B)" is not stripped).
+func stripOuterParens(expr string) (string, bool) {
+	if !strings.HasPrefix(expr, "(") || !strings.HasSuffix(expr, ")") {
+		return "", false
+	}
+	depth, braces := 0, 0
+	for i := 0; i < len(expr); i++ {
+		switch ch := expr[i]; {
+		case ch == '{':
+			braces++
+		case ch == '}' && braces > 0:
+			braces--
+		case braces > 0:
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+			if depth == 0 {
+				return expr[1:i], i == len(expr)-1
 			}
 		}
 	}
-
-	if current.Len() > 0 {
-		parts = append(parts, current.String())
-	}
-
-	return parts
+	return "", false
 }
 
 // evaluateSingleCondition evaluates a single condition (no logical operators)
@@ -1486,6 +2130,11 @@ func evaluateSingleCondition(
 		return false
 	}
 
+	// A {jsonpath} predicate on the raw resource bundle
+	if isJSONPathCondition(condition) {
+		return evaluateJSONPathCondition(ctx, details, condition, log)
+	}
+
 	// Check if it's a statusFeedback condition (contains ":")
 	if strings.Contains(condition, ":") {
 		return evaluateStatusFeedbackCondition(ctx, details, condition, log)
@@ -1838,6 +2487,8 @@ func compareNumeric(actual interface{}, expected string, operator string) bool {
 		actualNum = v
 	case int:
 		actualNum = float64(v)
+	case int32:
+		actualNum = float64(v)
 	case int64:
 		actualNum = float64(v)
 	case string:
@@ -1967,3 +2618,114 @@ func validateSearchQuery(value string) error {
 
 	return nil
 }
+
+// parseResourceStatus maps the resourceStatus entries of a resource bundle.
+// Agents report these loosely, especially for custom resources: entries that
+// are not objects are skipped, fields of the wrong type are left empty, and
+// conditions are dropped when they carry neither a type nor a status.
+func parseResourceStatus(entries []interface{}) []ResourceStatusInfo {
+	result := make([]ResourceStatusInfo, 0, len(entries))
+	for _, rs := range entries {
+		rsMap, ok := rs.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rsi := ResourceStatusInfo{}
+
+		// Extract resource meta
+		if meta, ok := rsMap["resourceMeta"].(map[string]interface{}); ok {
+			rsi.Kind, _ = meta["kind"].(string)
+			rsi.Name, _ = meta["name"].(string)
+			rsi.Namespace, _ = meta["namespace"].(string)
+			rsi.Group, _ = meta["group"].(string)
+			rsi.Version, _ = meta["version"].(string)
+			rsi.Resource, _ = meta["resource"].(string)
+		}
+
+		// Extract conditions
+		if conds, ok := rsMap["conditions"].([]interface{}); ok {
+			for _, cs := range parseConditions(conds) {
+				if cs.Type == "" && cs.Status == "" {
+					continue
+				}
+				rsi.Conditions = append(rsi.Conditions, cs)
+			}
+		}
+
+		// Extract status feedback
+		if feedback, ok := rsMap["statusFeedback"].(map[string]interface{}); ok {
+			rsi.StatusFeedback = parseStatusFeedback(feedback)
+		}
+
+		result = append(result, rsi)
+	}
+	return result
+}
+
+// parseConditions reads a status conditions array with every field a
+// condition carries, so the summaries in list and describe output are as
+// complete as the status itself. Entries that are not objects are skipped.
+func parseConditions(entries []interface{}) []ConditionSummary {
+	conditions := make([]ConditionSummary, 0, len(entries))
+	for _, entry := range entries {
+		cond, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cs := ConditionSummary{}
+		cs.Type, _ = cond["type"].(string)
+		cs.Status, _ = cond["status"].(string)
+		cs.Reason, _ = cond["reason"].(string)
+		cs.Message, _ = cond["message"].(string)
+		cs.LastTransitionTime, _ = cond["lastTransitionTime"].(string)
+		if og, ok := cond["observedGeneration"].(float64); ok {
+			cs.ObservedGeneration = int64(og)
+		}
+		conditions = append(conditions, cs)
+	}
+	return conditions
+}
+
+// parseStatusFeedback maps statusFeedback.values to name → value, or nil when
+// no value can be read.
+func parseStatusFeedback(feedback map[string]interface{}) map[string]interface{} {
+	values, ok := feedback["values"].([]interface{})
+	if !ok || len(values) == 0 {
+		return nil
+	}
+	result := make(map[string]interface{})
+	for _, v := range values {
+		valMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := valMap["name"].(string)
+		if !ok {
+			continue
+		}
+		fv, ok := valMap["fieldValue"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if strVal, ok := fv["string"].(string); ok {
+			result[name] = strVal
+		} else if intVal, ok := fv["integer"].(float64); ok {
+			result[name] = int64(intVal)
+		} else if boolVal, ok := fv["boolean"].(bool); ok {
+			result[name] = boolVal
+		} else if jsonRaw, ok := fv["jsonRaw"].(string); ok {
+			// Parse JSON raw string into interface{}
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(jsonRaw), &parsed); err == nil {
+				result[name] = parsed
+			} else {
+				// If parsing fails, store as raw string
+				result[name] = jsonRaw
+			}
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
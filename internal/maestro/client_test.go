@@ -1,11 +1,25 @@
 package maestro
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
@@ -367,6 +381,20 @@ func TestSplitByOperator(t *testing.T) {
 			op2:      "&&",
 			expected: []string{"A", "B", "C"},
 		},
+		{
+			name:     "operators inside jsonpath",
+			expr:     `A AND {.items[?(@.a=="x" && @.b)].c}>=1`,
+			op1:      "AND",
+			op2:      "&&",
+			expected: []string{"A", `{.items[?(@.a=="x" && @.b)].c}>=1`},
+		},
+		{
+			name:     "operator word inside a name",
+			expr:     "BRAND AND ANDROID",
+			op1:      "AND",
+			op2:      "&&",
+			expected: []string{"BRAND", "ANDROID"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -386,3 +414,856 @@ func TestSplitByOperator(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    map[string]string
+		expectError bool
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: nil,
+		},
+		{
+			name:     "single pair",
+			input:    "region=us-west",
+			expected: map[string]string{"region": "us-west"},
+		},
+		{
+			name:     "multiple pairs with spaces",
+			input:    "region=us-west, env = prod ,",
+			expected: map[string]string{"region": "us-west", "env": "prod"},
+		},
+		{
+			name:     "empty value",
+			input:    "team=",
+			expected: map[string]string{"team": ""},
+		},
+		{
+			name:        "missing equals",
+			input:       "region",
+			expectError: true,
+		},
+		{
+			name:        "missing key",
+			input:       "=prod",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels, err := ParseLabels(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error for %q, got none", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for %q: %v", tt.input, err)
+			}
+			if len(labels) != len(tt.expected) {
+				t.Fatalf("expected %d labels, got %d: %v", len(tt.expected), len(labels), labels)
+			}
+			for k, v := range tt.expected {
+				if labels[k] != v {
+					t.Errorf("label %q: expected %q, got %q", k, v, labels[k])
+				}
+			}
+		})
+	}
+}
+
+func TestParseLabelChanges(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		set         map[string]string
+		remove      []string
+		expectError bool
+	}{
+		{
+			name:  "set only",
+			input: "region=us-west,env=prod",
+			set:   map[string]string{"region": "us-west", "env": "prod"},
+		},
+		{
+			name:   "remove only",
+			input:  "team-, env-",
+			remove: []string{"team", "env"},
+		},
+		{
+			name:   "set and remove",
+			input:  "env=prod,team-",
+			set:    map[string]string{"env": "prod"},
+			remove: []string{"team"},
+		},
+		{
+			name:  "value ending in a dash is a value",
+			input: "suffix=a-",
+			set:   map[string]string{"suffix": "a-"},
+		},
+		{
+			name:        "bare dash",
+			input:       "-",
+			expectError: true,
+		},
+		{
+			name:        "missing equals",
+			input:       "region",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, remove, err := ParseLabelChanges(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error for %q, got none", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for %q: %v", tt.input, err)
+			}
+			if len(set) != len(tt.set) {
+				t.Fatalf("expected %d labels to set, got %v", len(tt.set), set)
+			}
+			for k, v := range tt.set {
+				if set[k] != v {
+					t.Errorf("label %q: expected %q, got %q", k, v, set[k])
+				}
+			}
+			if strings.Join(remove, ",") != strings.Join(tt.remove, ",") {
+				t.Errorf("expected removals %v, got %v", tt.remove, remove)
+			}
+		})
+	}
+}
+
+func TestFormatLabels(t *testing.T) {
+	labels := map[string]string{"region": "us-west", "env": "prod", "team": ""}
+	formatted := FormatLabels(labels)
+	if formatted != "env=prod,region=us-west,team=" {
+		t.Errorf("unexpected format: %q", formatted)
+	}
+	parsed, err := ParseLabels(formatted)
+	if err != nil {
+		t.Fatalf("formatted labels do not parse: %v", err)
+	}
+	if len(parsed) != len(labels) {
+		t.Errorf("round trip lost labels: %v", parsed)
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		expected       int32
+		actual         int32
+		expectConflict bool
+	}{
+		{name: "unchanged", expected: 3, actual: 3},
+		{name: "modified", expected: 3, actual: 4, expectConflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkVersion("my-work", tt.expected, tt.actual)
+
+			if !tt.expectConflict {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.IsConflict(err) {
+				t.Errorf("expected a conflict error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceBundleToDetailsSparseResourceStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   []interface{}
+		expected []ResourceStatusInfo
+	}{
+		{
+			name:     "empty entry",
+			status:   []interface{}{map[string]interface{}{}},
+			expected: []ResourceStatusInfo{{}},
+		},
+		{
+			name:     "non-object entries are skipped",
+			status:   []interface{}{"garbage", nil, 42.0},
+			expected: []ResourceStatusInfo{},
+		},
+		{
+			name: "meta of the wrong type",
+			status: []interface{}{map[string]interface{}{
+				"resourceMeta": "deployment",
+				"conditions":   nil,
+			}},
+			expected: []ResourceStatusInfo{{}},
+		},
+		{
+			name: "custom resource with only resource and name",
+			status: []interface{}{map[string]interface{}{
+				"resourceMeta": map[string]interface{}{"resource": "widgets", "name": 7.0},
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Applied", "status": "True"},
+					map[string]interface{}{"reason": "NoTypeOrStatus"},
+					"garbage",
+					map[string]interface{}{"status": "False"},
+				},
+			}},
+			expected: []ResourceStatusInfo{{
+				Resource: "widgets",
+				Conditions: []ConditionSummary{
+					{Type: "Applied", Status: "True"},
+					{Status: "False"},
+				},
+			}},
+		},
+		{
+			name: "feedback without readable values",
+			status: []interface{}{map[string]interface{}{
+				"resourceMeta":   map[string]interface{}{"kind": "ConfigMap", "name": "cm"},
+				"statusFeedback": map[string]interface{}{"values": []interface{}{map[string]interface{}{"name": "x"}}},
+			}},
+			expected: []ResourceStatusInfo{{Kind: "ConfigMap", Name: "cm"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rb := &openapi.ResourceBundle{Status: map[string]interface{}{"resourceStatus": tt.status}}
+			got := ResourceBundleToDetails(rb, "c1").ResourceStatus
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseConditions(t *testing.T) {
+	entries := []interface{}{
+		map[string]interface{}{
+			"type":               "Applied",
+			"status":             "True",
+			"reason":             "AppliedManifestWorkComplete",
+			"message":            "Apply manifest work complete",
+			"lastTransitionTime": "2024-01-02T10:00:00Z",
+			"observedGeneration": 3.0,
+		},
+		"garbage",
+		map[string]interface{}{"type": "Available", "status": 1.0},
+	}
+	expected := []ConditionSummary{
+		{
+			Type:               "Applied",
+			Status:             "True",
+			Reason:             "AppliedManifestWorkComplete",
+			Message:            "Apply manifest work complete",
+			LastTransitionTime: "2024-01-02T10:00:00Z",
+			ObservedGeneration: 3,
+		},
+		{Type: "Available"},
+	}
+	if got := parseConditions(entries); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestResourceStatusInfoDisplayName(t *testing.T) {
+	tests := []struct {
+		name     string
+		rs       ResourceStatusInfo
+		expected string
+	}{
+		{name: "kind and name", rs: ResourceStatusInfo{Kind: "Deployment", Resource: "deployments", Name: "web"}, expected: "Deployment/web"},
+		{name: "resource fallback", rs: ResourceStatusInfo{Resource: "widgets", Name: "w1"}, expected: "widgets/w1"},
+		{name: "missing name", rs: ResourceStatusInfo{Kind: "ConfigMap"}, expected: "ConfigMap/(unnamed)"},
+		{name: "empty", rs: ResourceStatusInfo{}, expected: "Unknown/(unnamed)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rs.DisplayName(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsTerminating(t *testing.T) {
+	healthy := []ConditionSummary{{Type: "Applied", Status: "True"}, {Type: "Available", Status: "True"}}
+
+	tests := []struct {
+		name       string
+		deletedAt  string
+		conditions []ConditionSummary
+		expected   bool
+	}{
+		{name: "healthy", conditions: healthy, expected: false},
+		{name: "no conditions", expected: false},
+		{name: "deletion requested", deletedAt: "2024-01-01T00:00:00Z", conditions: healthy, expected: true},
+		{name: "terminating condition", conditions: []ConditionSummary{{Type: "Terminating", Status: "True"}}, expected: true},
+		{name: "deleted condition", conditions: []ConditionSummary{{Type: "deleted", Status: "True"}}, expected: true},
+		{name: "false terminating condition", conditions: []ConditionSummary{{Type: "Terminating", Status: "False"}}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTerminating(tt.deletedAt, tt.conditions); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsFailing(t *testing.T) {
+	applied := ConditionSummary{Type: "Applied", Status: "True"}
+
+	tests := []struct {
+		name       string
+		deletedAt  string
+		conditions []ConditionSummary
+		expected   bool
+	}{
+		{name: "healthy", conditions: []ConditionSummary{applied, {Type: "Available", Status: "True"}}},
+		{name: "no conditions"},
+		{name: "not available", conditions: []ConditionSummary{applied, {Type: "Available", Status: "False"}}, expected: true},
+		{name: "only applied", conditions: []ConditionSummary{applied}, expected: true},
+		{name: "terminating", deletedAt: "2024-01-01T00:00:00Z", conditions: []ConditionSummary{applied}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsFailing(tt.deletedAt, tt.conditions); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestProbeEndpoint(t *testing.T) {
+	serve := func(status int) string {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+		}))
+		t.Cleanup(server.Close)
+		return server.URL
+	}
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		expectError bool
+	}{
+		{name: "ok", endpoint: serve(http.StatusOK)},
+		{name: "unauthorized still answers", endpoint: serve(http.StatusUnauthorized)},
+		{name: "server error", endpoint: serve(http.StatusServiceUnavailable), expectError: true},
+		{name: "nothing listening", endpoint: closed.URL, expectError: true},
+		{name: "invalid url", endpoint: "http://[::1", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			err := ProbeEndpoint(ctx, tt.endpoint, ClientConfig{})
+			if (err != nil) != tt.expectError {
+				t.Errorf("ProbeEndpoint(%s) error = %v, expected error: %v", tt.endpoint, err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestProbeEndpointConfig(t *testing.T) {
+	var path string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	config := ClientConfig{GRPCInsecure: true, APIPrefix: "/maestro/api/maestro/v1/"}
+	if err := ProbeEndpoint(ctx, server.URL, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path != "/maestro/api/maestro/v1" {
+		t.Errorf("expected the probe to use the API prefix, got %q", path)
+	}
+
+	config.TLSMinVersion = "1.3"
+	if err := ProbeEndpoint(ctx, server.URL, config); err == nil {
+		t.Error("expected a TLS 1.2 server to fail a probe requiring TLS 1.3")
+	}
+}
+
+func TestImpersonation(t *testing.T) {
+	var user string
+	var groups []string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, groups = r.Header.Get("Impersonate-User"), r.Header.Values("Impersonate-Group")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"kind": "ConsumerList", "page": 1, "size": 0, "total": 0, "items": []}`))
+	}))
+	defer server.Close()
+
+	quiet := logger.New(logger.Config{Level: "error", Format: "text"})
+	client, err := NewHTTPClient(ClientConfig{
+		HTTPEndpoint:      server.URL,
+		ImpersonateUser:   "alice",
+		ImpersonateGroups: []string{"sre", "oncall"},
+		Logger:            quiet,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if _, err := client.ListConsumers(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user != "alice" || !reflect.DeepEqual(groups, []string{"sre", "oncall"}) {
+		t.Errorf("expected impersonation of alice in sre and oncall, got user %q, groups %q", user, groups)
+	}
+
+	status = http.StatusForbidden
+	if _, err := client.ListConsumers(context.Background()); err == nil || !strings.Contains(err.Error(), "impersonat") {
+		t.Errorf("expected a rejected impersonation to be reported, got %v", err)
+	}
+
+	if _, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL, ImpersonateGroups: []string{"sre"}}); err == nil {
+		t.Error("expected groups without a user to be rejected")
+	}
+}
+
+func TestGzipResponses(t *testing.T) {
+	// A bundle of Deployments with their synced status, as a watch fetches it
+	var manifests []map[string]interface{}
+	var statuses []interface{}
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("service-%02d", i)
+		manifests = append(manifests, map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name": name, "namespace": "workloads", "labels": map[string]interface{}{"app": name},
+			},
+			"spec": map[string]interface{}{
+				"replicas": 3,
+				"template": map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{
+					map[string]interface{}{"name": name, "image": "quay.io/example/" + name + ":1.2.3"},
+				}}},
+			},
+		})
+		statuses = append(statuses, map[string]interface{}{
+			"resourceMeta": map[string]interface{}{"kind": "Deployment", "name": name, "namespace": "workloads"},
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Applied", "status": "True", "reason": "AppliedManifestComplete"},
+				map[string]interface{}{"type": "Available", "status": "True", "reason": "ResourceAvailable"},
+			},
+		})
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"id":        "bundle-1",
+		"name":      "services",
+		"manifests": manifests,
+		"status":    map[string]interface{}{"resourceStatus": statuses},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal bundle: %v", err)
+	}
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write(body); err != nil {
+		t.Fatalf("failed to compress bundle: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("failed to compress bundle: %v", err)
+	}
+	t.Logf("bundle: %d bytes, %d gzipped", len(body), compressed.Len())
+
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.Contains(acceptEncoding, "gzip") {
+			_, _ = w.Write(body)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(compressed.Bytes())
+	}))
+	defer server.Close()
+
+	// Impersonation wraps the transport too; compression must survive it
+	client, err := NewHTTPClient(ClientConfig{
+		HTTPEndpoint:    server.URL,
+		ImpersonateUser: "alice",
+		Logger:          logger.New(logger.Config{Level: "error", Format: "text"}),
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	rb, err := client.GetResourceBundleHTTP(context.Background(), "bundle-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if acceptEncoding != "gzip" {
+		t.Errorf("expected the request to accept gzip, got Accept-Encoding %q", acceptEncoding)
+	}
+	if len(rb.Manifests) != len(manifests) {
+		t.Errorf("expected %d manifests from the gzipped response, got %d", len(manifests), len(rb.Manifests))
+	}
+}
+
+func TestTLSMinVersion(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind": "ConsumerList", "page": 1, "size": 0, "total": 0, "items": []}`))
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.Config.ErrorLog = log.New(io.Discard, "", 0) // the refused handshake is expected
+	server.StartTLS()
+	defer server.Close()
+
+	quiet := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range []struct {
+		version     string
+		expectError string
+	}{
+		{version: ""},
+		{version: "1.2"},
+		{version: "1.3", expectError: "does not support TLS 1.3 or later"},
+	} {
+		client, err := NewHTTPClient(ClientConfig{
+			HTTPEndpoint:  server.URL,
+			GRPCInsecure:  true,
+			TLSMinVersion: tt.version,
+			Logger:        quiet,
+		})
+		if err != nil {
+			t.Fatalf("%q: failed to create client: %v", tt.version, err)
+		}
+		_, err = client.ListConsumers(context.Background())
+		if tt.expectError == "" && err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.version, err)
+		}
+		if tt.expectError != "" && (err == nil || !strings.Contains(err.Error(), tt.expectError)) {
+			t.Errorf("%q: expected an error containing %q, got %v", tt.version, tt.expectError, err)
+		}
+	}
+
+	if err := ValidateTLSMinVersion("1.1"); err == nil {
+		t.Error("expected TLS 1.1 to be rejected")
+	}
+	if _, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL, TLSMinVersion: "1.0"}); err == nil {
+		t.Error("expected a client with an unsupported minimum version to fail")
+	}
+}
+
+func TestAPIPrefix(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "/resource-bundles/") {
+			_, _ = w.Write([]byte(`{"id": "bundle-1", "name": "web"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"kind": "ConsumerList", "page": 1, "size": 0, "total": 0, "items": []}`))
+	}))
+	defer server.Close()
+
+	quiet := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range []struct {
+		endpoint string
+		prefix   string
+		expected []string
+	}{
+		{
+			endpoint: server.URL,
+			expected: []string{"/api/maestro/v1/consumers", "/api/maestro/v1/resource-bundles/bundle-1"},
+		},
+		{
+			endpoint: server.URL + "/proxy",
+			prefix:   "/api/maestro/v2/",
+			expected: []string{"/proxy/api/maestro/v2/consumers", "/proxy/api/maestro/v2/resource-bundles/bundle-1"},
+		},
+	} {
+		paths = nil
+		client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: tt.endpoint, APIPrefix: tt.prefix, Logger: quiet})
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		if _, err := client.ListConsumers(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := client.GetResourceBundleHTTP(context.Background(), "bundle-1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(paths, tt.expected) {
+			t.Errorf("prefix %q: expected requests to %v, got %v", tt.prefix, tt.expected, paths)
+		}
+	}
+
+	if _, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL, APIPrefix: "api/v2"}); err == nil {
+		t.Error("expected a prefix without a leading slash to be rejected")
+	}
+}
+
+func TestGetResourceBundleStatusHTTP(t *testing.T) {
+	var requests []string
+	rejectFields := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields := r.URL.Query().Get("fields")
+		requests = append(requests, fields)
+		w.Header().Set("Content-Type", "application/json")
+		if fields != "" && rejectFields {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"kind": "Error", "reason": "unknown field"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"kind": "ResourceBundleList", "page": 1, "size": 1, "total": 1, "items": [{
+			"id": "bundle-1", "version": 2, "metadata": {"name": "web"},
+			"manifests": [{"kind": "Deployment"}], "delete_option": {"propagationPolicy": "Foreground"},
+			"status": {"conditions": [{"type": "Applied", "status": "True"}]}
+		}]}`))
+	}))
+	defer server.Close()
+
+	quiet := logger.New(logger.Config{Level: "error", Format: "text"})
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL, Logger: quiet})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	for _, reject := range []bool{false, true} {
+		requests, rejectFields = nil, reject
+		status, err := client.GetResourceBundleStatusHTTP(context.Background(), "agent1", "web", quiet)
+		if err != nil {
+			t.Fatalf("reject %v: unexpected error: %v", reject, err)
+		}
+		if status.ID != "bundle-1" || status.Version != 2 || status.Status == nil {
+			t.Errorf("reject %v: expected the bundle's identity and status, got %+v", reject, status)
+		}
+		if status.Manifests != nil || status.DeleteOption != nil {
+			t.Errorf("reject %v: expected no manifests or delete option, got %+v", reject, status)
+		}
+		expected := []string{statusFields}
+		if reject {
+			expected = append(expected, "") // retried without field selection
+		}
+		if !reflect.DeepEqual(requests, expected) {
+			t.Errorf("reject %v: expected requests with fields %q, got %q", reject, expected, requests)
+		}
+	}
+
+	if err := ValidateSubresource("spec"); err == nil {
+		t.Error("expected an unsupported subresource to be rejected")
+	}
+}
+
+func TestManifestKinds(t *testing.T) {
+	manifests := []ManifestInfo{
+		{Kind: "Job", Name: "migrate"},
+		{Kind: "ConfigMap", Name: "settings"},
+		{Kind: "Job", Name: "cleanup"},
+		{Name: "no-kind"},
+	}
+	if got := ManifestKinds(manifests); !reflect.DeepEqual(got, []string{"Job", "ConfigMap"}) {
+		t.Errorf("expected [Job ConfigMap], got %v", got)
+	}
+	if got := ManifestKinds(nil); got != nil {
+		t.Errorf("expected no kinds, got %v", got)
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var requests []string
+	failures := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind": "ConsumerList", "page": 1, "size": 0, "total": 0, "items": []}`))
+	}))
+	defer server.Close()
+
+	quiet := logger.New(logger.Config{Level: "error", Format: "text"})
+	client, err := NewHTTPClient(ClientConfig{
+		HTTPEndpoint: server.URL, MaxRetries: 2, RetryBackoff: time.Millisecond, Logger: quiet,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	failures = 2
+	if _, err := client.ListConsumers(context.Background()); err != nil {
+		t.Fatalf("expected two 503s to be retried, got %v", err)
+	}
+	if len(requests) != 3 {
+		t.Errorf("expected 3 attempts, got %d", len(requests))
+	}
+
+	requests, failures = nil, 3
+	if _, err := client.ListConsumers(context.Background()); err == nil {
+		t.Error("expected an error once the retries are used up")
+	}
+	if len(requests) != 3 {
+		t.Errorf("expected 1 attempt and 2 retries, got %d requests", len(requests))
+	}
+
+	// A POST may already have taken effect, so it is not repeated
+	requests, failures = nil, 1
+	if _, err := client.CreateConsumer(context.Background(), "agent1", nil); err == nil {
+		t.Error("expected the 503 of a POST to be returned")
+	}
+	if !reflect.DeepEqual(requests, []string{http.MethodPost}) {
+		t.Errorf("expected a single POST, got %v", requests)
+	}
+
+	for _, tt := range []struct {
+		retries int
+		backoff time.Duration
+	}{{-1, 0}, {MaxRetries + 1, 0}, {1, -time.Second}} {
+		if err := ValidateRetries(tt.retries, tt.backoff); err == nil {
+			t.Errorf("expected %d retries with backoff %s to be rejected", tt.retries, tt.backoff)
+		}
+	}
+}
+
+func TestListConsumersPages(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page+"/"+r.URL.Query().Get("size"))
+		items := map[string]string{
+			"1": `{"id": "c1", "name": "agent1"}, {"id": "c2", "name": "agent2"}`,
+			"2": `{"id": "c3", "name": "agent3"}`,
+		}[page]
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind": "ConsumerList", "page": ` + page +
+			`, "size": 2, "total": 3, "items": [` + items + `]}`))
+	}))
+	defer server.Close()
+
+	quiet := logger.New(logger.Config{Level: "error", Format: "text"})
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL, Logger: quiet, PageSize: 2})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	consumers, total, err := client.ListConsumersPage(context.Background(), 1)
+	if err != nil || total != 3 || len(consumers) != 2 || consumers[1].Name != "agent2" {
+		t.Fatalf("expected the first 2 of 3 consumers, got %+v, total %d, %v", consumers, total, err)
+	}
+
+	pages = nil
+	names, err := client.ListConsumers(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"agent1", "agent2", "agent3"}) {
+		t.Errorf("expected every consumer across pages, got %v", names)
+	}
+	if !reflect.DeepEqual(pages, []string{"1/2", "2/2"}) {
+		t.Errorf("expected pages 1 and 2 of size 2, got %v", pages)
+	}
+}
+
+func TestGetManifestWorkOnLaterPage(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		items := map[string]string{
+			"1": `{"id": "rb1", "metadata": {"name": "first"}, "version": 1},
+				{"id": "rb2", "metadata": {"name": "second"}, "version": 1}`,
+			"2": `{"id": "rb3", "metadata": {"name": "third"}, "version": 4}`,
+		}[page]
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind": "ResourceBundleList", "page": ` + page +
+			`, "size": 2, "total": 3, "items": [` + items + `]}`))
+	}))
+	defer server.Close()
+
+	quiet := logger.New(logger.Config{Level: "error", Format: "text"})
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL, Logger: quiet, PageSize: 2})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	summary, err := client.GetManifestWorkByNameHTTP(context.Background(), "agent1", "third")
+	if err != nil || summary.ID != "rb3" || summary.Version != 4 {
+		t.Fatalf("expected third from page 2, got %+v, %v", summary, err)
+	}
+	details, err := client.GetManifestWorkDetailsHTTP(context.Background(), "agent1", "third")
+	if err != nil || details.ID != "rb3" {
+		t.Fatalf("expected the details of third from page 2, got %+v, %v", details, err)
+	}
+
+	pages = nil
+	if _, err := client.GetManifestWorkByNameHTTP(context.Background(), "agent1", "first"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(pages, []string{"1"}) {
+		t.Errorf("expected the search to stop at the page with the work, got pages %v", pages)
+	}
+	if _, err := client.GetManifestWorkByNameHTTP(context.Background(), "agent1", "missing"); !errors.IsNotFound(err) {
+		t.Errorf("expected NotFound after the last page, got %v", err)
+	}
+}
+
+func TestValidatePageSize(t *testing.T) {
+	for size, valid := range map[int]bool{-1: false, 0: false, 1: true, MaxPageSize: true, MaxPageSize + 1: false} {
+		if err := ValidatePageSize(size); (err == nil) != valid {
+			t.Errorf("ValidatePageSize(%d) error = %v, expected valid: %v", size, err, valid)
+		}
+	}
+}
+
+func TestListConsumersIgnoredPage(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind": "ConsumerList", "page": 1, "size": 2, "total": 3, "items": [` +
+			`{"id": "c1", "name": "agent1"}, {"id": "c2", "name": "agent2"}]}`))
+	}))
+	defer server.Close()
+
+	quiet := logger.New(logger.Config{Level: "error", Format: "text"})
+	client, err := NewHTTPClient(ClientConfig{HTTPEndpoint: server.URL, Logger: quiet, PageSize: 2})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	consumers, err := client.ListConsumersWithDetails(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(consumers) != 2 || requests != 2 {
+		t.Errorf("expected 2 consumers after 2 requests, got %+v after %d", consumers, requests)
+	}
+}
@@ -0,0 +1,181 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// ConditionNotMetError is returned by WaitForCondition when the wait ends
+// (timeout or cancellation) before the condition is met. Details is the state
+// seen by the last successful poll, so callers can explain what was missing.
+type ConditionNotMetError struct {
+	Condition string
+	Details   *ManifestWorkDetails
+	Err       error
+}
+
+func (e *ConditionNotMetError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ConditionNotMetError) Unwrap() error {
+	return e.Err
+}
+
+// ExplainCondition describes, one line per term of a --for expression, whether
+// the term is met in details and what the relevant conditions currently say:
+// their status (or that they are absent), reason and last message.
+func ExplainCondition(
+	ctx context.Context,
+	details *ManifestWorkDetails,
+	expr string,
+	log *logger.Logger,
+) []string {
+	if details == nil {
+		return []string{"no status was observed"}
+	}
+	terms := conditionTerms(expr)
+	lines := make([]string, 0, len(terms))
+	for _, term := range terms {
+		state := "not met"
+		if evaluateSingleCondition(ctx, details, term, log) {
+			state = "met"
+		}
+		var facts string
+		switch {
+		case isJSONPathCondition(term):
+			facts = explainJSONPathTerm(details, term)
+		case strings.Contains(term, ":"):
+			facts = explainResourceTerm(details, term)
+		default:
+			facts = explainCondition(findCondition(details.Conditions, term), term)
+		}
+		lines = append(lines, fmt.Sprintf("%s [%s]: %s", term, state, facts))
+	}
+	return lines
+}
+
+// conditionTerms returns the distinct single conditions of expr, in order,
+// with grouping and the AND/OR operators removed.
+func conditionTerms(expr string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	var collect func(expr string)
+	collect = func(expr string) {
+		expr = strings.TrimSpace(expr)
+		if parts := splitByOperator(expr, "OR", "||"); len(parts) > 1 {
+			for _, part := range parts {
+				collect(part)
+			}
+			return
+		}
+		if parts := splitByOperator(expr, "AND", "&&"); len(parts) > 1 {
+			for _, part := range parts {
+				collect(part)
+			}
+			return
+		}
+		if inner, ok := stripOuterParens(expr); ok {
+			collect(inner)
+			return
+		}
+		if expr != "" && !seen[expr] {
+			seen[expr] = true
+			terms = append(terms, expr)
+		}
+	}
+	collect(expr)
+	return terms
+}
+
+// explainJSONPathTerm describes the value a {jsonpath} term selects
+func explainJSONPathTerm(details *ManifestWorkDetails, term string) string {
+	c, err := parseJSONPathCondition(term)
+	if err != nil {
+		return err.Error()
+	}
+	value, found, err := c.lookup(details.raw)
+	switch {
+	case err != nil:
+		return err.Error()
+	case !found:
+		return c.path + " absent"
+	}
+	return fmt.Sprintf("%s is %v", c.path, value)
+}
+
+// explainResourceTerm describes the resources matched by a "Kind[/ns][/name]:check" term
+func explainResourceTerm(details *ManifestWorkDetails, term string) string {
+	selector, check, _ := strings.Cut(term, ":")
+	selector, check = strings.TrimSpace(selector), strings.TrimSpace(check)
+	selectorParts := strings.Split(selector, "/")
+	kind := selectorParts[0]
+	var name, namespace string
+	if len(selectorParts) == 2 {
+		name = selectorParts[1]
+	} else if len(selectorParts) >= 3 {
+		namespace = selectorParts[1]
+		name = selectorParts[2]
+	}
+
+	field, isComparison := comparisonField(check)
+	var found []string
+	for _, rs := range details.ResourceStatus {
+		if !strings.EqualFold(rs.Kind, kind) ||
+			(name != "" && !strings.EqualFold(rs.Name, name)) ||
+			(namespace != "" && !strings.EqualFold(rs.Namespace, namespace)) {
+			continue
+		}
+		if isComparison {
+			value := "absent"
+			if v := getValueFromPath(rs.StatusFeedback, field); v != nil {
+				value = fmt.Sprintf("%v", v)
+			}
+			found = append(found, fmt.Sprintf("%s %s is %s", rs.DisplayName(), field, value))
+			continue
+		}
+		found = append(found, rs.DisplayName()+" "+explainCondition(findCondition(rs.Conditions, check), check))
+	}
+	if len(found) == 0 {
+		return fmt.Sprintf("no %s resource reported", selector)
+	}
+	return strings.Join(found, "; ")
+}
+
+// comparisonField returns the status feedback field of a comparison check such
+// as "succeeded>=1", and false when check is a plain condition type.
+func comparisonField(check string) (string, bool) {
+	if i := strings.IndexAny(check, "<>="); i >= 0 {
+		return strings.TrimSpace(check[:i]), true
+	}
+	return "", false
+}
+
+// findCondition returns the condition of the given type (case-insensitive), or nil
+func findCondition(conditions []ConditionSummary, condType string) *ConditionSummary {
+	for i := range conditions {
+		if strings.EqualFold(conditions[i].Type, condType) {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// explainCondition renders c as "Type=Status (Reason): message", or notes that
+// the condition is absent.
+func explainCondition(c *ConditionSummary, condType string) string {
+	if c == nil {
+		return condType + " absent"
+	}
+	s := c.Type + "=" + c.Status
+	if c.Reason != "" {
+		s += " (" + c.Reason + ")"
+	}
+	if c.Message != "" {
+		s += ": " + c.Message
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+package maestro
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestExplainCondition(t *testing.T) {
+	details := &ManifestWorkDetails{
+		Conditions: []ConditionSummary{
+			{Type: "Applied", Status: "True"},
+			{Type: "Available", Status: "False", Reason: "ResourcesNotAvailable", Message: "1 of 2 resources available"},
+		},
+		ResourceStatus: []ResourceStatusInfo{
+			{
+				Kind:           "Job",
+				Name:           "migrate",
+				Conditions:     []ConditionSummary{{Type: "Applied", Status: "True"}},
+				StatusFeedback: map[string]interface{}{"succeeded": int64(0)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		expr      string
+		noDetails bool
+		expected  []string
+	}{
+		{
+			name: "workload conditions",
+			expr: "Applied AND Available",
+			expected: []string{
+				"Applied [met]: Applied=True",
+				"Available [not met]: Available=False (ResourcesNotAvailable): 1 of 2 resources available",
+			},
+		},
+		{
+			name:     "absent condition",
+			expr:     "Degraded",
+			expected: []string{"Degraded [not met]: Degraded absent"},
+		},
+		{
+			name: "resource terms with grouping and duplicates",
+			expr: "(Job:Complete OR Job:succeeded>=1) AND Job:Complete",
+			expected: []string{
+				"Job:Complete [not met]: Job/migrate Complete absent",
+				"Job:succeeded>=1 [not met]: Job/migrate succeeded is 0",
+			},
+		},
+		{
+			name:     "missing resource",
+			expr:     "Deployment/web:Available",
+			expected: []string{"Deployment/web:Available [not met]: no Deployment/web resource reported"},
+		},
+		{
+			name:      "no observed status",
+			expr:      "Available",
+			noDetails: true,
+			expected:  []string{"no status was observed"},
+		},
+	}
+
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := details
+			if tt.noDetails {
+				d = nil
+			}
+			got := ExplainCondition(context.Background(), d, tt.expr, log)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package maestro
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+// jsonPathOperators are the comparisons accepted after a {jsonpath}, longest first
+var jsonPathOperators = []string{">=", "<=", "!=", "==", "=", ">", "<"}
+
+// jsonPathCondition is a "{path}" or "{path}<op><value>" term of a condition
+// expression, evaluated against the resource bundle as shown by get -o json.
+type jsonPathCondition struct {
+	path     string // the JSONPath template, braces included
+	operator string // empty to test that the value is present and not false, "" or 0
+	value    string
+}
+
+// isJSONPathCondition reports whether a single condition is a {jsonpath} term
+func isJSONPathCondition(condition string) bool {
+	return strings.HasPrefix(strings.TrimSpace(condition), "{")
+}
+
+// parseJSONPathCondition parses and compiles a {jsonpath} term
+func parseJSONPathCondition(condition string) (*jsonPathCondition, error) {
+	condition = strings.TrimSpace(condition)
+	end := closingBrace(condition)
+	if end < 0 {
+		return nil, fmt.Errorf("unterminated jsonpath in %q", condition)
+	}
+
+	c := &jsonPathCondition{path: condition[:end+1]}
+	if rest := strings.TrimSpace(condition[end+1:]); rest != "" {
+		for _, op := range jsonPathOperators {
+			if strings.HasPrefix(rest, op) {
+				c.operator = op
+				c.value = strings.Trim(strings.TrimSpace(rest[len(op):]), `"'`)
+				break
+			}
+		}
+		if c.operator == "" {
+			return nil, fmt.Errorf("expected one of %s after %s, got %q",
+				strings.Join(jsonPathOperators, " "), c.path, rest)
+		}
+		if c.value == "" {
+			return nil, fmt.Errorf("missing value after %s%s", c.path, c.operator)
+		}
+	}
+
+	if _, err := c.compile(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// closingBrace returns the index of the brace closing the one at s[0], or -1
+func closingBrace(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (c *jsonPathCondition) compile() (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New("for").AllowMissingKeys(true)
+	if err := jp.Parse(c.path); err != nil {
+		return nil, fmt.Errorf("invalid jsonpath %s: %w", c.path, err)
+	}
+	return jp, nil
+}
+
+// lookup returns the value the path selects in data. found is false when the
+// path selects nothing; selecting several values is an error, as a comparison
+// against them would be ambiguous.
+func (c *jsonPathCondition) lookup(data map[string]interface{}) (value interface{}, found bool, err error) {
+	jp, err := c.compile()
+	if err != nil {
+		return nil, false, err
+	}
+	results, err := jp.FindResults(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to evaluate jsonpath %s: %w", c.path, err)
+	}
+	var values []interface{}
+	for _, r := range results {
+		for _, v := range r {
+			values = append(values, v.Interface())
+		}
+	}
+	switch len(values) {
+	case 0:
+		return nil, false, nil
+	case 1:
+		return values[0], values[0] != nil, nil
+	default:
+		return nil, false, fmt.Errorf("jsonpath %s matches %d values, expected one", c.path, len(values))
+	}
+}
+
+// matches reports whether a found value satisfies the term
+func (c *jsonPathCondition) matches(value interface{}) bool {
+	switch c.operator {
+	case "":
+		return isTruthy(value)
+	case "=", "==":
+		return compareEqual(value, c.value)
+	case "!=":
+		return !compareEqual(value, c.value)
+	default:
+		return compareNumeric(value, c.value, c.operator)
+	}
+}
+
+// isTruthy is false for false, "", "false" (any case, as in a condition
+// status), zero and empty collections
+func isTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != "" && !strings.EqualFold(v, "false")
+	case float64:
+		return v != 0
+	case int32:
+		return v != 0
+	case int64:
+		return v != 0
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return true
+}
+
+// evaluateJSONPathCondition evaluates a {jsonpath} term against the raw
+// resource bundle. Absent values and evaluation errors do not match.
+func evaluateJSONPathCondition(
+	ctx context.Context,
+	details *ManifestWorkDetails,
+	condition string,
+	log *logger.Logger,
+) bool {
+	c, err := parseJSONPathCondition(condition)
+	if err != nil {
+		log.Debug(ctx, "Invalid jsonpath condition", logger.Fields{"condition": condition, "error": err.Error()})
+		return false
+	}
+	value, found, err := c.lookup(details.raw)
+	if err != nil {
+		log.Debug(ctx, "Failed to evaluate jsonpath condition", logger.Fields{
+			"condition": condition,
+			"error":     err.Error(),
+		})
+		return false
+	}
+	if !found {
+		log.Debug(ctx, "JSONPath selected no value", logger.Fields{"condition": condition})
+		return false
+	}
+	matched := c.matches(value)
+	log.Debug(ctx, "Evaluated jsonpath condition", logger.Fields{
+		"condition": condition,
+		"value":     fmt.Sprintf("%v", value),
+		"matched":   matched,
+	})
+	return matched
+}
+
+// ValidateConditionExpression checks a --for/--wait expression for errors that
+// would make it never match, such as an invalid {jsonpath} term, so they are
+// reported before waiting rather than as a timeout.
+func ValidateConditionExpression(expr string) error {
+	terms := conditionTerms(expr)
+	if len(terms) == 0 {
+		return fmt.Errorf("empty condition expression")
+	}
+	for _, term := range terms {
+		if !isJSONPathCondition(term) {
+			continue
+		}
+		if _, err := parseJSONPathCondition(term); err != nil {
+			return fmt.Errorf("invalid condition %q: %w", term, err)
+		}
+	}
+	return nil
+}
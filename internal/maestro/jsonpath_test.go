@@ -0,0 +1,101 @@
+package maestro
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestEvaluateMixedConditionExpression(t *testing.T) {
+	details := &ManifestWorkDetails{
+		Conditions: []ConditionSummary{
+			{Type: "Applied", Status: "True"},
+			{Type: "Available", Status: "True"},
+		},
+		raw: map[string]interface{}{
+			"version": int32(4),
+			"status": map[string]interface{}{
+				"readyReplicas": float64(3),
+				"phase":         "Running",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True"},
+					map[string]interface{}{"type": "Degraded", "status": "False"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		expr     string
+		noRaw    bool
+		expected bool
+	}{
+		{name: "jsonpath comparison", expr: "{.status.readyReplicas}>=3", expected: true},
+		{name: "jsonpath comparison false", expr: "{.status.readyReplicas} > 3", expected: false},
+		{name: "condition AND jsonpath", expr: "Available AND {.status.readyReplicas}>=3", expected: true},
+		{name: "condition AND failing jsonpath", expr: "Available && {.status.readyReplicas}>=4", expected: false},
+		{name: "jsonpath equality", expr: `{.status.phase}="running"`, expected: true},
+		{name: "jsonpath inequality", expr: "{.status.phase}!=Running", expected: false},
+		{name: "int32 field", expr: "{.version}>=4", expected: true},
+		{name: "bare jsonpath present", expr: "{.status.phase}", expected: true},
+		{name: "bare jsonpath absent", expr: "{.status.missing}", expected: false},
+		{
+			name:     "filter with operators and parentheses inside the jsonpath",
+			expr:     `Applied AND {.status.conditions[?(@.type=="Ready")].status}=True`,
+			expected: true,
+		},
+		{name: "bare jsonpath reading False", expr: `{.status.conditions[?(@.type=="Degraded")].status}`, expected: false},
+		{name: "AND binds tighter than OR", expr: "Degraded AND Applied OR {.status.readyReplicas}=3", expected: true},
+		{name: "OR inside AND", expr: "Degraded OR Applied AND {.status.readyReplicas}=4", expected: false},
+		{name: "parentheses override precedence", expr: "(Degraded OR Applied) AND {.status.readyReplicas}=3", expected: true},
+		{name: "nested parentheses", expr: "((Applied) AND ({.status.phase}=Running OR Degraded))", expected: true},
+		{name: "several values do not match", expr: "{.status.conditions[*].status}=True", expected: false},
+		{name: "invalid jsonpath does not match", expr: "Available AND {.status[}", expected: false},
+		{name: "no raw bundle", expr: "{.status.readyReplicas}>=3", noRaw: true, expected: false},
+	}
+
+	log := logger.New(logger.Config{Level: "error", Format: "text"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := details
+			if tt.noRaw {
+				d = &ManifestWorkDetails{Conditions: details.Conditions}
+			}
+			result := evaluateConditionExpression(context.Background(), d, tt.expr, log)
+			if result != tt.expected {
+				t.Errorf("evaluateConditionExpression(%q) = %v, expected %v", tt.expr, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateConditionExpression(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		expectError bool
+	}{
+		{name: "condition", expr: "Available"},
+		{name: "mixed", expr: "Available AND ({.status.readyReplicas}>=3 OR Job:Complete)"},
+		{name: "filter", expr: `{.status.conditions[?(@.type=="Ready")].status}=True`},
+		{name: "empty", expr: "  ", expectError: true},
+		{name: "unterminated jsonpath", expr: "Available AND {.status.readyReplicas>=3", expectError: true},
+		{name: "invalid jsonpath", expr: "{.status[}=1", expectError: true},
+		{name: "unknown operator", expr: "{.status.readyReplicas}~3", expectError: true},
+		{name: "missing value", expr: "{.status.readyReplicas}>=", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConditionExpression(tt.expr)
+			if tt.expectError && err == nil {
+				t.Fatal("expected error")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
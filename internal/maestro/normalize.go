@@ -0,0 +1,106 @@
+package maestro
+
+import (
+	"fmt"
+
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+// OutputVersionManifestWork is the --output-version that renders a resource
+// bundle as a clean, re-appliable ManifestWork
+var OutputVersionManifestWork = workv1.GroupVersion.String()
+
+// serverMetadataFields are metadata fields populated by the server, which a
+// manifest checked into git should not carry
+var serverMetadataFields = []string{
+	"managedFields",
+	"resourceVersion",
+	"uid",
+	"creationTimestamp",
+	"deletionTimestamp",
+	"generation",
+	"selfLink",
+}
+
+// ValidateOutputVersion checks an --output-version value; empty keeps the
+// resource bundle as Maestro returns it.
+func ValidateOutputVersion(version string) error {
+	if version != "" && version != OutputVersionManifestWork {
+		return fmt.Errorf("unsupported output version %q (supported: %s)", version, OutputVersionManifestWork)
+	}
+	return nil
+}
+
+// NormalizeManifestWork turns a resource bundle map, as built by
+// ResourceBundleToRawMap, into a ManifestWork that can be re-applied with
+// apply --manifest-file: the bundle's name, labels, annotations, manifests and
+// delete option, without status or server-populated metadata such as
+// managedFields, resourceVersion, uid and creationTimestamp. raw is not
+// modified.
+func NormalizeManifestWork(raw map[string]interface{}) map[string]interface{} {
+	metadata := map[string]interface{}{}
+	if name, ok := raw["name"].(string); ok && name != "" {
+		metadata["name"] = name
+	}
+	if bundleMeta, ok := raw["metadata"].(map[string]interface{}); ok {
+		for _, key := range []string{"labels", "annotations"} {
+			if v, ok := bundleMeta[key].(map[string]interface{}); ok && len(v) > 0 {
+				metadata[key] = v
+			}
+		}
+	}
+
+	spec := map[string]interface{}{}
+	var manifests []interface{}
+	switch items := raw["manifests"].(type) {
+	case []map[string]interface{}:
+		for _, item := range items {
+			manifests = append(manifests, normalizeManifest(item))
+		}
+	case []interface{}:
+		for _, item := range items {
+			if obj, ok := item.(map[string]interface{}); ok {
+				manifests = append(manifests, normalizeManifest(obj))
+			}
+		}
+	}
+	if manifests == nil {
+		manifests = []interface{}{}
+	}
+	spec["workload"] = map[string]interface{}{"manifests": manifests}
+	if deleteOption, ok := raw["deleteOption"].(map[string]interface{}); ok && len(deleteOption) > 0 {
+		spec["deleteOption"] = deleteOption
+	}
+
+	return map[string]interface{}{
+		"apiVersion": OutputVersionManifestWork,
+		"kind":       "ManifestWork",
+		"metadata":   metadata,
+		"spec":       spec,
+	}
+}
+
+// normalizeManifest returns a copy of a manifest without its status and
+// server-populated metadata
+func normalizeManifest(manifest map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(manifest))
+	for k, v := range manifest {
+		if k == "status" {
+			continue
+		}
+		out[k] = v
+	}
+	metadata, ok := manifest["metadata"].(map[string]interface{})
+	if !ok {
+		return out
+	}
+	cleaned := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		cleaned[k] = v
+	}
+	for _, field := range serverMetadataFields {
+		delete(cleaned, field)
+	}
+	out["metadata"] = cleaned
+	return out
+}
@@ -0,0 +1,99 @@
+package maestro
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeManifestWork(t *testing.T) {
+	raw := map[string]interface{}{
+		"id":           "0b6f3c2e",
+		"name":         "nginx",
+		"consumerName": "cluster-west-1",
+		"version":      int32(3),
+		"createdAt":    "2026-03-01T08:00:00Z",
+		"metadata": map[string]interface{}{
+			"name":              "nginx",
+			"uid":               "1234",
+			"resourceVersion":   "3",
+			"creationTimestamp": "2026-03-01T08:00:00Z",
+			"labels":            map[string]interface{}{"app": "nginx"},
+			"annotations":       map[string]interface{}{},
+		},
+		"deleteOption": map[string]interface{}{"propagationPolicy": "Foreground"},
+		"manifests": []interface{}{
+			map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":              "nginx",
+					"namespace":         "web",
+					"uid":               "5678",
+					"resourceVersion":   "42",
+					"creationTimestamp": "2026-03-01T08:00:00Z",
+					"generation":        float64(2),
+					"managedFields":     []interface{}{map[string]interface{}{"manager": "kubectl"}},
+				},
+				"spec":   map[string]interface{}{"replicas": float64(2)},
+				"status": map[string]interface{}{"readyReplicas": float64(2)},
+			},
+		},
+		"status": map[string]interface{}{"conditions": []interface{}{}},
+	}
+
+	expected := map[string]interface{}{
+		"apiVersion": "work.open-cluster-management.io/v1",
+		"kind":       "ManifestWork",
+		"metadata": map[string]interface{}{
+			"name":   "nginx",
+			"labels": map[string]interface{}{"app": "nginx"},
+		},
+		"spec": map[string]interface{}{
+			"deleteOption": map[string]interface{}{"propagationPolicy": "Foreground"},
+			"workload": map[string]interface{}{
+				"manifests": []interface{}{
+					map[string]interface{}{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"metadata":   map[string]interface{}{"name": "nginx", "namespace": "web"},
+						"spec":       map[string]interface{}{"replicas": float64(2)},
+					},
+				},
+			},
+		},
+	}
+
+	got := NormalizeManifestWork(raw)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+
+	// The input is left untouched
+	manifest := raw["manifests"].([]interface{})[0].(map[string]interface{})
+	if _, ok := manifest["status"]; !ok {
+		t.Error("expected the raw manifest to keep its status")
+	}
+	if _, ok := manifest["metadata"].(map[string]interface{})["uid"]; !ok {
+		t.Error("expected the raw manifest to keep its uid")
+	}
+}
+
+func TestValidateOutputVersion(t *testing.T) {
+	tests := []struct {
+		version     string
+		expectError bool
+	}{
+		{version: ""},
+		{version: "work.open-cluster-management.io/v1"},
+		{version: "v1", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			err := ValidateOutputVersion(tt.version)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateOutputVersion(%q) error = %v, expected error: %v", tt.version, err, tt.expectError)
+			}
+		})
+	}
+}
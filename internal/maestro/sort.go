@@ -0,0 +1,80 @@
+package maestro
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Sort keys accepted by SortResourceBundles
+const (
+	SortByName   = "name"
+	SortByAge    = "age"
+	SortByStatus = "status"
+)
+
+// SortKeys returns the keys accepted by SortResourceBundles, in help-text order
+func SortKeys() []string {
+	return []string{SortByName, SortByAge, SortByStatus}
+}
+
+// resourceBundleLess reports whether a sorts before b for each sort key.
+// Age sorts newest first; status sorts failing works first, then works
+// without conditions, then healthy ones.
+var resourceBundleLess = map[string]func(a, b ResourceBundleSummary) bool{
+	SortByName: func(a, b ResourceBundleSummary) bool {
+		return a.Name < b.Name
+	},
+	SortByAge: func(a, b ResourceBundleSummary) bool {
+		return createdAt(a).After(createdAt(b))
+	},
+	SortByStatus: func(a, b ResourceBundleSummary) bool {
+		return statusRank(a.Conditions) < statusRank(b.Conditions)
+	},
+}
+
+// ValidateSortKey checks that by is one of SortKeys
+func ValidateSortKey(by string) error {
+	if _, ok := resourceBundleLess[strings.ToLower(by)]; !ok {
+		return fmt.Errorf("unknown sort key %q (valid: %s)", by, strings.Join(SortKeys(), ", "))
+	}
+	return nil
+}
+
+// SortResourceBundles sorts items in place by the given key, in reverse when
+// reverse is set. The sort is stable, so items with equal keys keep the order
+// the server returned them in.
+func SortResourceBundles(items []ResourceBundleSummary, by string, reverse bool) error {
+	if err := ValidateSortKey(by); err != nil {
+		return err
+	}
+	less := resourceBundleLess[strings.ToLower(by)]
+	sort.SliceStable(items, func(i, j int) bool {
+		if reverse {
+			return less(items[j], items[i])
+		}
+		return less(items[i], items[j])
+	})
+	return nil
+}
+
+// createdAt parses the creation timestamp; unparsable ones sort as the oldest
+func createdAt(rb ResourceBundleSummary) time.Time {
+	t, err := time.Parse(time.RFC3339, rb.CreatedAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// statusRank orders works by health: 0 failing, 1 no conditions yet, 2 applied and available
+func statusRank(conditions []ConditionSummary) int {
+	if len(conditions) == 0 {
+		return 1
+	}
+	if IsAppliedAndAvailable(conditions) {
+		return 2
+	}
+	return 0
+}
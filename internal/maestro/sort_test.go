@@ -0,0 +1,57 @@
+package maestro
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortResourceBundles(t *testing.T) {
+	healthy := []ConditionSummary{{Type: "Applied", Status: "True"}, {Type: "Available", Status: "True"}}
+	failing := []ConditionSummary{{Type: "Applied", Status: "True"}, {Type: "Available", Status: "False"}}
+
+	items := []ResourceBundleSummary{
+		{Name: "web", CreatedAt: "2024-01-02T00:00:00Z", Conditions: healthy},
+		{Name: "api", CreatedAt: "2024-01-03T00:00:00Z", Conditions: failing},
+		{Name: "db", CreatedAt: "2024-01-01T00:00:00Z"},
+		{Name: "cache", CreatedAt: "2024-01-03T00:00:00Z", Conditions: healthy},
+	}
+
+	tests := []struct {
+		name        string
+		by          string
+		reverse     bool
+		expected    []string
+		expectError bool
+	}{
+		{name: "name", by: "name", expected: []string{"api", "cache", "db", "web"}},
+		{name: "name reversed", by: "name", reverse: true, expected: []string{"web", "db", "cache", "api"}},
+		{name: "age keeps server order for equal timestamps", by: "age", expected: []string{"api", "cache", "web", "db"}},
+		{name: "age reversed", by: "age", reverse: true, expected: []string{"db", "web", "api", "cache"}},
+		{name: "status", by: "STATUS", expected: []string{"api", "db", "web", "cache"}},
+		{name: "status reversed", by: "status", reverse: true, expected: []string{"web", "cache", "db", "api"}},
+		{name: "unknown key", by: "size", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Clone(items)
+			err := SortResourceBundles(got, tt.by, tt.reverse)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			names := make([]string, 0, len(got))
+			for _, rb := range got {
+				names = append(names, rb.Name)
+			}
+			if !slices.Equal(names, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, names)
+			}
+		})
+	}
+}
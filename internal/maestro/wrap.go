@@ -0,0 +1,61 @@
+package maestro
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// WrapText soft-wraps text into lines of at most width terminal columns,
+// breaking at spaces and splitting only words that are wider than a whole
+// line. Line breaks already in text are kept. A width below 1 returns text
+// unwrapped as its own lines.
+func WrapText(text string, width int) []string {
+	if width < 1 {
+		return strings.Split(text, "\n")
+	}
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		lines = append(lines, wrapParagraph(paragraph, width)...)
+	}
+	return lines
+}
+
+func wrapParagraph(paragraph string, width int) []string {
+	words := strings.Fields(paragraph)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	var line strings.Builder
+	lineW := 0
+	for _, word := range words {
+		wordW := runewidth.StringWidth(word)
+		if lineW > 0 && lineW+1+wordW <= width {
+			line.WriteByte(' ')
+			line.WriteString(word)
+			lineW += 1 + wordW
+			continue
+		}
+		if lineW > 0 {
+			lines = append(lines, line.String())
+			line.Reset()
+			lineW = 0
+		}
+		// A word wider than the line is split at the column limit
+		for wordW > width {
+			head := runewidth.Truncate(word, width, "")
+			if head == "" {
+				// A single rune wider than width; take it anyway to make progress
+				head = string([]rune(word)[:1])
+			}
+			lines = append(lines, head)
+			word = word[len(head):]
+			wordW = runewidth.StringWidth(word)
+		}
+		line.WriteString(word)
+		lineW = wordW
+	}
+	return append(lines, line.String())
+}
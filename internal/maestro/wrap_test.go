@@ -0,0 +1,41 @@
+package maestro
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		expected []string
+	}{
+		{name: "fits", text: "all good", width: 20, expected: []string{"all good"}},
+		{
+			name:     "breaks at spaces",
+			text:     "the quick brown fox jumps over the lazy dog",
+			width:    16,
+			expected: []string{"the quick brown", "fox jumps over", "the lazy dog"},
+		},
+		{name: "collapses runs of spaces", text: "a   b", width: 10, expected: []string{"a b"}},
+		{
+			name:     "splits words wider than a line",
+			text:     "see abcdefghijkl",
+			width:    5,
+			expected: []string{"see", "abcde", "fghij", "kl"},
+		},
+		{name: "keeps line breaks", text: "first\nsecond", width: 20, expected: []string{"first", "second"}},
+		{name: "wide runes count double", text: "配置 错误 信息", width: 9, expected: []string{"配置 错误", "信息"}},
+		{name: "no width", text: "not wrapped at all", width: 0, expected: []string{"not wrapped at all"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WrapText(tt.text, tt.width); !slices.Equal(got, tt.expected) {
+				t.Errorf("WrapText(%q, %d) = %q, expected %q", tt.text, tt.width, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package manifestwork
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// manifestFileExtensions are the file types picked up from a directory
+var manifestFileExtensions = []string{".yaml", ".yml", ".json"}
+
+// ResolveManifestFiles expands a --manifest-file argument into the files to
+// load, in a stable order:
+//
+//   - an existing file is returned as is
+//   - a directory yields every .yaml, .yml and .json file under it, following
+//     symlinks to files and directories (each directory is visited once, so
+//     symlink loops are harmless)
+//   - otherwise a shell-style glob such as "manifests/*.yaml" is expanded by
+//     the CLI itself, since not every shell expands patterns in every context;
+//     matched directories are expanded as above
+//
+// A pattern that matches nothing, or only directories without manifest files,
+// is an error rather than a literal file name or an empty set.
+func ResolveManifestFiles(pattern string) ([]string, error) {
+	matches := []string{pattern}
+	if _, err := os.Stat(pattern); err != nil {
+		if !hasGlobMeta(pattern) {
+			return nil, fmt.Errorf("failed to read %s: %w", pattern, err)
+		}
+		matches, err = filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest file pattern %q: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match %q", pattern)
+		}
+	}
+
+	visited := map[string]bool{}
+	var files []string
+	for _, match := range matches {
+		expanded, err := expandManifestPath(match, visited)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, expanded...)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no %s files found in %q", strings.Join(manifestFileExtensions, ", "), pattern)
+	}
+	return files, nil
+}
+
+// expandManifestPath returns path itself when it is a file, or the manifest
+// files under it when it is a directory. visited holds the real paths of the
+// directories already walked.
+func expandManifestPath(path string, visited map[string]bool) ([]string, error) {
+	info, err := os.Stat(path) // follows symlinks
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	real, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", path, err)
+	}
+	if visited[real] {
+		return nil, nil
+	}
+	visited[real] = true
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", path, err)
+	}
+	var files []string
+	for _, entry := range entries {
+		child := filepath.Join(path, entry.Name())
+		// entry.Type() describes a symlink itself, so stat through it
+		childInfo, err := os.Stat(child)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", child, err)
+		}
+		if childInfo.IsDir() {
+			nested, err := expandManifestPath(child, visited)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, nested...)
+			continue
+		}
+		if isManifestFile(child) {
+			files = append(files, child)
+		}
+	}
+	slices.Sort(files)
+	return files, nil
+}
+
+func isManifestFile(path string) bool {
+	return slices.Contains(manifestFileExtensions, strings.ToLower(filepath.Ext(path)))
+}
+
+func hasGlobMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[`)
+}
@@ -0,0 +1,87 @@
+package manifestwork
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveManifestFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(rel string) {
+		path := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("kind: ManifestWork\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("manifests/a.yaml")
+	write("manifests/b.json")
+	write("manifests/notes.txt")
+	write("manifests/nested/c.yml")
+	write("shared/d.yaml")
+	if err := os.Mkdir(filepath.Join(dir, "empty"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	symlink := func(target, rel string) {
+		if err := os.Symlink(target, filepath.Join(dir, rel)); err != nil {
+			t.Skipf("symlinks unavailable: %v", err)
+		}
+	}
+	symlink(filepath.Join(dir, "shared"), "manifests/linked")
+	symlink(filepath.Join(dir, "shared", "d.yaml"), "manifests/e.yaml")
+	symlink(filepath.Join(dir, "manifests"), "manifests/nested/loop")
+
+	tests := []struct {
+		name          string
+		pattern       string
+		expected      []string // relative to dir
+		expectedError string
+	}{
+		{name: "single file", pattern: "manifests/a.yaml", expected: []string{"manifests/a.yaml"}},
+		{
+			name:    "directory follows symlinks and skips other files",
+			pattern: "manifests",
+			expected: []string{
+				"manifests/a.yaml", "manifests/b.json", "manifests/e.yaml",
+				"manifests/linked/d.yaml", "manifests/nested/c.yml",
+			},
+		},
+		{name: "glob", pattern: "manifests/*.yaml", expected: []string{"manifests/a.yaml", "manifests/e.yaml"}},
+		{name: "glob matching a directory", pattern: "shar*", expected: []string{"shared/d.yaml"}},
+		{name: "zero-match glob", pattern: "manifests/*.yamll", expectedError: "no files match"},
+		{name: "glob picks files of any extension", pattern: "manifests/*.txt", expected: []string{"manifests/notes.txt"}},
+		{name: "directory without manifests", pattern: "empty", expectedError: "no .yaml, .yml, .json files found"},
+		{name: "missing file", pattern: "manifests/missing.yaml", expectedError: "failed to read"},
+		{name: "invalid glob", pattern: "manifests/[", expectedError: "invalid manifest file pattern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := ResolveManifestFiles(filepath.Join(dir, tt.pattern))
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("expected error containing %q, got %v (files %v)", tt.expectedError, err, files)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := make([]string, len(files))
+			for i, f := range files {
+				rel, err := filepath.Rel(dir, f)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got[i] = filepath.ToSlash(rel)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
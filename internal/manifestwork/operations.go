@@ -36,6 +36,10 @@ type StatusResult struct {
 	Message   string    `json:"message"`   // Human-readable message
 	Timestamp time.Time `json:"timestamp"` // When this result was recorded
 
+	// DurationSeconds is how long a wait took from invocation until its
+	// condition was met; zero (omitted) for other results
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+
 	// Detailed status
 	Conditions []ConditionInfo  `json:"conditions,omitempty"` // ManifestWork-level conditions
 	Resources  []ResourceStatus `json:"resources,omitempty"`  // Per-manifest status with K8s conditions
@@ -112,6 +116,62 @@ func LoadFromFile(filePath string) (*workv1.ManifestWork, error) {
 	return &manifestWork, nil
 }
 
+// ValidateManifestWork performs a lightweight structural check of a ManifestWork before it is
+// sent to the server. It returns one message per problem, each naming the offending field path
+// (e.g. "spec.workload.manifests[1] (Deployment): metadata.name is required"); nil means valid.
+func ValidateManifestWork(mw *workv1.ManifestWork) []string {
+	var problems []string
+
+	if mw.APIVersion == "" {
+		problems = append(problems, "apiVersion is required")
+	}
+	if mw.Kind == "" {
+		problems = append(problems, "kind is required")
+	}
+	if mw.Name == "" {
+		problems = append(problems, "metadata.name is required")
+	}
+	if len(mw.Spec.Workload.Manifests) == 0 {
+		problems = append(problems, "spec.workload.manifests cannot be empty")
+	}
+
+	for i, manifest := range mw.Spec.Workload.Manifests {
+		path := fmt.Sprintf("spec.workload.manifests[%d]", i)
+		if len(manifest.Raw) == 0 {
+			problems = append(problems, path+" is empty")
+			continue
+		}
+
+		var m map[string]interface{}
+		if err := UnmarshalManifest(manifest.Raw, &m); err != nil {
+			problems = append(problems, fmt.Sprintf("%s is not valid YAML/JSON: %v", path, err))
+			continue
+		}
+
+		// Name the kind when known so the entry is easy to find in the file
+		if kind, ok := m["kind"].(string); ok && kind != "" {
+			path = fmt.Sprintf("%s (%s)", path, kind)
+		}
+
+		if v, ok := m["apiVersion"].(string); !ok || v == "" {
+			problems = append(problems, path+": apiVersion is required")
+		}
+		if v, ok := m["kind"].(string); !ok || v == "" {
+			problems = append(problems, path+": kind is required")
+		}
+		metadata, ok := m["metadata"].(map[string]interface{})
+		if !ok {
+			problems = append(problems, path+": metadata is required")
+			continue
+		}
+		if v, ok := metadata["name"].(string); !ok || v == "" {
+			problems = append(problems, path+": metadata.name is required")
+		}
+	}
+
+	return problems
+}
+
 // LoadSourceFile loads a source configuration file that can be:
 // - A full ManifestWork
 // - Just the spec portion
@@ -410,13 +470,51 @@ func WriteResult(resultsPath string, result StatusResult) error {
 
 	// Use 0600: owner read/write only (most secure, no group/world access)
 	// Results files contain status info for status-reporter integration
-	if err := os.WriteFile(resultsPath, data, 0600); err != nil {
+	if err := writeFileAtomic(resultsPath, data, 0600); err != nil {
 		return fmt.Errorf("failed to write results to %s: %w", resultsPath, err)
 	}
 
 	return nil
 }
 
+// writeFileAtomic replaces path with data so that readers see either the old
+// or the new content, never a partial write: data goes to a temporary file in
+// the same directory, which is then renamed over path. An existing file keeps
+// its permissions; a new one gets perm.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	if info, err := os.Stat(path); err == nil {
+		if info.IsDir() {
+			return fmt.Errorf("%s is a directory", path)
+		}
+		perm = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	// Remove the temporary file unless it was renamed into place
+	defer os.Remove(tmp.Name()) //nolint:errcheck // already gone after a successful rename
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	// Flush to disk before the rename, so a crash cannot leave an empty file in place
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
 // BuildStatusResult creates a StatusResult from ManifestWorkDetails
 // This is a shared helper function used by multiple commands to avoid code duplication
 func BuildStatusResult(name, consumer, status, message string, details *maestro.ManifestWorkDetails) StatusResult {
@@ -1,8 +1,12 @@
 package manifestwork
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -402,3 +406,181 @@ func TestBuildStatusResult(t *testing.T) {
 		t.Error("expected non-zero timestamp")
 	}
 }
+
+func TestValidateManifestWork(t *testing.T) {
+	newWork := func(raws ...string) *workv1.ManifestWork {
+		mw := &workv1.ManifestWork{
+			TypeMeta:   metav1.TypeMeta{APIVersion: apiVersionManifestWork, Kind: kindManifestWork},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-work"},
+		}
+		for _, raw := range raws {
+			mw.Spec.Workload.Manifests = append(mw.Spec.Workload.Manifests, workv1.Manifest{
+				RawExtension: runtime.RawExtension{Raw: []byte(raw)},
+			})
+		}
+		return mw
+	}
+
+	tests := []struct {
+		name     string
+		work     *workv1.ManifestWork
+		expected []string
+	}{
+		{
+			name:     "valid",
+			work:     newWork(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"}}`),
+			expected: nil,
+		},
+		{
+			name:     "no manifests",
+			work:     newWork(),
+			expected: []string{"spec.workload.manifests cannot be empty"},
+		},
+		{
+			name: "missing manifest name",
+			work: newWork(
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"}}`,
+				`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"default"}}`,
+			),
+			expected: []string{"spec.workload.manifests[1] (Deployment): metadata.name is required"},
+		},
+		{
+			name: "missing kind and metadata",
+			work: newWork(`{"apiVersion":"v1"}`),
+			expected: []string{
+				"spec.workload.manifests[0]: kind is required",
+				"spec.workload.manifests[0]: metadata is required",
+			},
+		},
+		{
+			name: "missing work name",
+			work: func() *workv1.ManifestWork {
+				mw := newWork(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"}}`)
+				mw.Name = ""
+				return mw
+			}(),
+			expected: []string{"metadata.name is required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := ValidateManifestWork(tt.work)
+
+			if len(problems) != len(tt.expected) {
+				t.Fatalf("expected %d problem(s), got %d: %v", len(tt.expected), len(problems), problems)
+			}
+			for i := range tt.expected {
+				if problems[i] != tt.expected[i] {
+					t.Errorf("problem[%d]: expected %q, got %q", i, tt.expected[i], problems[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWriteResultConcurrentReaders(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "results.json")
+	// A long message makes a torn, non-atomic write likely to be observed
+	message := strings.Repeat("x", 64*1024)
+	if err := WriteResult(path, StatusResult{Name: "w", Status: "InProgress", Message: message}); err != nil {
+		t.Fatalf("failed to write initial result: %v", err)
+	}
+
+	const writes = 50
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				data, err := os.ReadFile(path)
+				if err != nil {
+					errs <- fmt.Errorf("read failed: %w", err)
+					return
+				}
+				var result StatusResult
+				if err := json.Unmarshal(data, &result); err != nil {
+					errs <- fmt.Errorf("read a partial result (%d bytes): %w", len(data), err)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := range writes {
+		result := StatusResult{Name: "w", Status: "InProgress", Message: fmt.Sprintf("%s-%d", message, i)}
+		if err := WriteResult(path, result); err != nil {
+			t.Fatalf("write %d failed: %v", i, err)
+		}
+	}
+	close(done)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to list %s: %v", dir, err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected only the results file to remain, got %d entries", len(entries))
+	}
+}
+
+func TestWriteResultPermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing *os.FileMode // nil means the file does not exist yet
+		expected os.FileMode
+	}{
+		{name: "new file", expected: 0o600},
+		{name: "existing file keeps its mode", existing: fileMode(0o644), expected: 0o644},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "results.json")
+			if tt.existing != nil {
+				if err := os.WriteFile(path, []byte("{}"), *tt.existing); err != nil {
+					t.Fatalf("failed to create results file: %v", err)
+				}
+				// WriteFile is subject to the umask
+				if err := os.Chmod(path, *tt.existing); err != nil {
+					t.Fatalf("failed to chmod results file: %v", err)
+				}
+			}
+
+			if err := WriteResult(path, StatusResult{Name: "w", Status: "Applied"}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatalf("failed to stat results file: %v", err)
+			}
+			if info.Mode().Perm() != tt.expected {
+				t.Errorf("expected mode %v, got %v", tt.expected, info.Mode().Perm())
+			}
+		})
+	}
+}
+
+func TestWriteResultToDirectory(t *testing.T) {
+	if err := WriteResult(t.TempDir(), StatusResult{Name: "w"}); err == nil {
+		t.Fatal("expected an error writing results to a directory")
+	}
+}
+
+func fileMode(m os.FileMode) *os.FileMode {
+	return &m
+}
@@ -0,0 +1,110 @@
+package manifestwork
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+// Progress formats accepted by NewWaitReporter
+const (
+	ProgressLog    = "log"
+	ProgressGitHub = "github"
+)
+
+// ProgressFormats lists the --progress values of the wait command
+var ProgressFormats = []string{ProgressLog, ProgressGitHub}
+
+// WaitReporter surfaces a wait's progress in a CI system's UI, on top of the
+// regular log output. Implementations only format: ReportWaitProgress tracks
+// which conditions changed between polls.
+type WaitReporter interface {
+	// ConditionChanged is called when a condition first appears or its status
+	// changes; previous is the earlier status, empty when it just appeared.
+	ConditionChanged(cond maestro.ConditionSummary, previous string)
+	// Finished is called once when the wait ends: err is nil when the
+	// condition was met.
+	Finished(elapsed time.Duration, err error)
+}
+
+// NewWaitReporter returns the reporter for a --progress format writing to w,
+// or nil for ProgressLog (and an empty format), which keeps only the log
+// output.
+func NewWaitReporter(format string, w io.Writer, name, consumer, condition string) (WaitReporter, error) {
+	switch format {
+	case "", ProgressLog:
+		return nil, nil
+	case ProgressGitHub:
+		return &githubReporter{w: w, title: fmt.Sprintf("ManifestWork %s/%s", consumer, name), condition: condition}, nil
+	}
+	return nil, fmt.Errorf("unknown progress format %q (valid: %s)", format, strings.Join(ProgressFormats, ", "))
+}
+
+// ReportWaitProgress wraps next so each poll also tells reporter about
+// conditions that changed since the previous poll.
+func ReportWaitProgress(reporter WaitReporter, next maestro.WaitCallback) maestro.WaitCallback {
+	seen := map[string]string{}
+	return func(details *maestro.ManifestWorkDetails, conditionMet bool) error {
+		for _, cond := range details.Conditions {
+			if previous, ok := seen[cond.Type]; !ok || previous != cond.Status {
+				reporter.ConditionChanged(cond, previous)
+				seen[cond.Type] = cond.Status
+			}
+		}
+		if next != nil {
+			return next(details, conditionMet)
+		}
+		return nil
+	}
+}
+
+// githubReporter writes GitHub Actions workflow commands, which the Actions
+// UI shows as annotations on the run:
+//
+//	::notice title=ManifestWork agent1/my-job::Applied is now True (AppliedManifestComplete)
+//	::error title=ManifestWork agent1/my-job::error waiting for condition 'Job:Complete': ...
+type githubReporter struct {
+	w         io.Writer
+	title     string
+	condition string
+}
+
+func (r *githubReporter) ConditionChanged(cond maestro.ConditionSummary, previous string) {
+	message := fmt.Sprintf("%s is now %s", cond.Type, cond.Status)
+	if previous != "" {
+		message = fmt.Sprintf("%s changed from %s to %s", cond.Type, previous, cond.Status)
+	}
+	if cond.Reason != "" {
+		message += " (" + cond.Reason + ")"
+	}
+	if cond.Message != "" {
+		message += ": " + cond.Message
+	}
+	r.command("notice", message)
+}
+
+func (r *githubReporter) Finished(elapsed time.Duration, err error) {
+	if err != nil {
+		r.command("error", err.Error())
+		return
+	}
+	r.command("notice", fmt.Sprintf("Condition '%s' met after %s", r.condition, elapsed.Round(time.Second)))
+}
+
+func (r *githubReporter) command(level, message string) {
+	fmt.Fprintf(r.w, "::%s title=%s::%s\n", level, escapeGitHubProperty(r.title), escapeGitHubData(message))
+}
+
+// escapeGitHubData escapes a workflow command message, so a multi-line
+// message stays one command
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeGitHubProperty escapes a workflow command property value
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer(":", "%3A", ",", "%2C").Replace(escapeGitHubData(s))
+}
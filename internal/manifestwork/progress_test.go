@@ -0,0 +1,60 @@
+package manifestwork
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+func TestGitHubWaitReporter(t *testing.T) {
+	var out bytes.Buffer
+	reporter, err := NewWaitReporter(ProgressGitHub, &out, "my-job", "agent1", "Job:Complete")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	callback := ReportWaitProgress(reporter, nil)
+	for _, conds := range [][]maestro.ConditionSummary{
+		{{Type: "Applied", Status: "False", Reason: "Pending"}},
+		{{Type: "Applied", Status: "False", Reason: "Pending"}}, // unchanged: no annotation
+		{{Type: "Applied", Status: "True", Reason: "AppliedManifestComplete"}, {Type: "Available", Status: "True"}},
+	} {
+		if err := callback(&maestro.ManifestWorkDetails{Conditions: conds}, false); err != nil {
+			t.Fatalf("unexpected callback error: %v", err)
+		}
+	}
+	reporter.Finished(90*time.Second+400*time.Millisecond, nil)
+	reporter.Finished(time.Minute, errors.New("timed out\n  Job:Complete absent"))
+
+	title := "title=ManifestWork agent1/my-job::"
+	expected := strings.Join([]string{
+		"::notice " + title + "Applied is now False (Pending)",
+		"::notice " + title + "Applied changed from False to True (AppliedManifestComplete)",
+		"::notice " + title + "Available is now True",
+		"::notice " + title + "Condition 'Job:Complete' met after 1m30s",
+		"::error " + title + "timed out%0A  Job:Complete absent",
+	}, "\n") + "\n"
+	if out.String() != expected {
+		t.Errorf("expected annotations:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestNewWaitReporter(t *testing.T) {
+	for _, format := range []string{"", ProgressLog} {
+		if reporter, err := NewWaitReporter(format, &bytes.Buffer{}, "w", "c", "Available"); reporter != nil || err != nil {
+			t.Errorf("%q: expected no reporter and no error, got %v, %v", format, reporter, err)
+		}
+	}
+	if _, err := NewWaitReporter("gitlab", &bytes.Buffer{}, "w", "c", "Available"); err == nil {
+		t.Error("expected an unknown format to be rejected")
+	}
+}
+
+func TestEscapeGitHubProperty(t *testing.T) {
+	if got := escapeGitHubProperty("a:b,c%d"); got != "a%3Ab%2Cc%25d" {
+		t.Errorf("expected a%%3Ab%%2Cc%%25d, got %s", got)
+	}
+}
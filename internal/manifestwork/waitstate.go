@@ -0,0 +1,109 @@
+package manifestwork
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+// WaitStateVersion is the current version of the wait state-file format
+const WaitStateVersion = 1
+
+// WaitState is the progress of a wait, persisted with --state-file so a
+// restarted wait resumes against the original deadline instead of starting
+// its timeout over. The file is JSON:
+//
+//	{
+//	  "version": 1,
+//	  "name": "my-job",
+//	  "consumer": "agent1",
+//	  "for": "Job:Complete",
+//	  "timeout": "30m0s",
+//	  "elapsed": "12m4s",
+//	  "startedAt": "2024-01-02T10:00:00Z",
+//	  "updatedAt": "2024-01-02T10:14:10Z",
+//	  "conditionMet": false,
+//	  "conditions": [{"type": "Applied", "status": "True"}]
+//	}
+//
+// Elapsed counts only the time spent waiting, so time between a failed run and
+// its retry does not use up the deadline.
+type WaitState struct {
+	Version      int                        `json:"version"`
+	Name         string                     `json:"name"`
+	Consumer     string                     `json:"consumer"`
+	For          string                     `json:"for"`
+	Timeout      Duration                   `json:"timeout"`
+	Elapsed      Duration                   `json:"elapsed"`
+	StartedAt    time.Time                  `json:"startedAt"`
+	UpdatedAt    time.Time                  `json:"updatedAt"`
+	ConditionMet bool                       `json:"conditionMet"`
+	Conditions   []maestro.ConditionSummary `json:"conditions,omitempty"`
+}
+
+// Duration is a time.Duration that marshals as a string such as "1m30s"
+type Duration time.Duration
+
+// MarshalJSON encodes d as a duration string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON decodes a duration string
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// Matches reports whether the state was saved by a wait for the same
+// ManifestWork, consumer and condition, so it can be resumed.
+func (s *WaitState) Matches(name, consumer, condition string) bool {
+	return s.Version == WaitStateVersion && s.Name == name && s.Consumer == consumer && s.For == condition
+}
+
+// LoadWaitState reads a wait state file. A missing file returns (nil, nil); a
+// file that cannot be read or parsed returns an error, and callers start
+// fresh rather than fail the wait.
+func LoadWaitState(path string) (*WaitState, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read wait state from %s: %w", path, err)
+	}
+	state := &WaitState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse wait state from %s: %w", path, err)
+	}
+	if state.Elapsed < 0 {
+		return nil, fmt.Errorf("invalid wait state in %s: negative elapsed time", path)
+	}
+	return state, nil
+}
+
+// SaveWaitState writes state to path
+func SaveWaitState(path string, state *WaitState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal wait state: %w", err)
+	}
+	// Same permissions and atomic replace as results files, so a wait killed
+	// mid-save leaves the previous state to resume from
+	if err := writeFileAtomic(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write wait state to %s: %w", path, err)
+	}
+	return nil
+}
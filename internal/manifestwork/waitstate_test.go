@@ -0,0 +1,86 @@
+package manifestwork
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+func TestWaitStateRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	saved := &WaitState{
+		Version:    WaitStateVersion,
+		Name:       "my-job",
+		Consumer:   "agent1",
+		For:        "Job:Complete",
+		Timeout:    Duration(30 * time.Minute),
+		Elapsed:    Duration(12*time.Minute + 4*time.Second),
+		StartedAt:  time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC),
+		UpdatedAt:  time.Date(2024, 1, 2, 10, 14, 10, 0, time.UTC),
+		Conditions: []maestro.ConditionSummary{{Type: "Applied", Status: "True"}},
+	}
+	if err := SaveWaitState(path, saved); err != nil {
+		t.Fatalf("failed to save: %v", err)
+	}
+
+	loaded, err := LoadWaitState(path)
+	if err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+	if loaded.Elapsed != saved.Elapsed || loaded.Timeout != saved.Timeout || !loaded.StartedAt.Equal(saved.StartedAt) {
+		t.Errorf("expected %+v, got %+v", saved, loaded)
+	}
+	if !loaded.Matches("my-job", "agent1", "Job:Complete") {
+		t.Error("expected the loaded state to match its own wait")
+	}
+	if loaded.Matches("my-job", "agent1", "Available") {
+		t.Error("expected a different condition not to match")
+	}
+}
+
+func TestLoadWaitState(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     *string // nil means the file does not exist
+		expectNil   bool
+		expectError bool
+	}{
+		{name: "missing file", expectNil: true},
+		{name: "corrupt file", content: ptr("{not json"), expectError: true},
+		{name: "bad duration", content: ptr(`{"version": 1, "elapsed": "ten minutes"}`), expectError: true},
+		{name: "negative elapsed", content: ptr(`{"version": 1, "elapsed": "-1m"}`), expectError: true},
+		{name: "valid", content: ptr(`{"version": 1, "name": "w", "elapsed": "90s"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "state.json")
+			if tt.content != nil {
+				if err := os.WriteFile(path, []byte(*tt.content), 0o600); err != nil {
+					t.Fatalf("failed to write state: %v", err)
+				}
+			}
+
+			state, err := LoadWaitState(path)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got state %+v", state)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (state == nil) != tt.expectNil {
+				t.Errorf("expected nil state: %v, got %+v", tt.expectNil, state)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,323 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+	"github.com/muesli/termenv"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+// Kinds of bulk operation
+const (
+	bulkExport = "export" // fetch every ManifestWork of a consumer and copy them as JSON
+	bulkDelete = "delete" // delete the ManifestWorks shown in the list
+)
+
+// bulkBarWidth is the width of the progress bar in the bulk operation modal
+const bulkBarWidth = 36
+
+// bulkDeleteConcurrency is how many deletes a bulk delete keeps in flight,
+// the default --concurrency of the delete command.
+const bulkDeleteConcurrency = 4
+
+// bulkOperation is a bulk export or delete in progress. An export fetches one
+// ManifestWork at a time, in order; a delete keeps up to
+// bulkDeleteConcurrency requests in flight. The progress modal counts each
+// one as it finishes, and Esc stops the operation before the next request.
+type bulkOperation struct {
+	kind     string
+	consumer string
+	works    []maestro.ResourceBundleSummary // nil while an export is listing them
+	next     int                             // index of the next ManifestWork to send
+	inFlight int                             // requests sent and not finished yet
+	done     int                             // ManifestWorks finished, failed ones included
+	last     string                          // delete: the outcome of the last one finished
+	failures []string                        // "name: error" of each failed delete
+
+	bundles   []map[string]interface{} // export: the resource bundles fetched so far
+	cancelled bool                     // delete: no new request after those in flight
+	ctx       context.Context
+	cancel    context.CancelFunc
+	bar       progress.Model
+}
+
+// bulkListedMsg carries the ManifestWorks an export is about to fetch.
+type bulkListedMsg struct {
+	op    *bulkOperation
+	works []maestro.ResourceBundleSummary
+	err   error
+}
+
+// bulkItemMsg reports that the ManifestWork at index was handled; raw is the
+// resource bundle fetched by an export.
+type bulkItemMsg struct {
+	op    *bulkOperation
+	index int
+	raw   map[string]interface{}
+	err   error
+}
+
+func newBulkOperation(kind, consumer string, works []maestro.ResourceBundleSummary) *bulkOperation {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &bulkOperation{
+		kind:     kind,
+		consumer: consumer,
+		works:    works,
+		ctx:      ctx,
+		cancel:   cancel,
+		bar: progress.New(
+			progress.WithWidth(bulkBarWidth),
+			progress.WithoutPercentage(),
+			progress.WithFillCharacters('#', '-'),
+			progress.WithColorProfile(termenv.Ascii),
+		),
+	}
+}
+
+// startBulkExport fetches every ManifestWork of consumer for a copy to the
+// clipboard as one JSON array of resource bundles.
+func (m *Model) startBulkExport(consumer string) tea.Cmd {
+	op := newBulkOperation(bulkExport, consumer, nil)
+	m.bulk = op
+	m.loading = true
+	m.errMsg2 = ""
+	client := m.client
+	return tea.Batch(spinnerTick(), func() tea.Msg {
+		works, err := client.ListManifestWorksHTTP(op.ctx, consumer)
+		return bulkListedMsg{op: op, works: works, err: err}
+	})
+}
+
+// startBulkDelete deletes works, the ManifestWorks shown for consumer,
+// bulkDeleteConcurrency at a time.
+func (m *Model) startBulkDelete(consumer string, works []maestro.ResourceBundleSummary) tea.Cmd {
+	op := newBulkOperation(bulkDelete, consumer, works)
+	m.bulk = op
+	m.loading = true
+	m.errMsg2 = ""
+	cmds := []tea.Cmd{spinnerTick()}
+	for op.next < len(works) && op.inFlight < bulkDeleteConcurrency {
+		cmds = append(cmds, m.bulkStepCmd(op))
+	}
+	return tea.Batch(cmds...)
+}
+
+// bulkStepCmd sends the request for the next ManifestWork of op. A delete is
+// not tied to op.ctx: once sent, it is left to finish so the count reported
+// is accurate.
+func (m Model) bulkStepCmd(op *bulkOperation) tea.Cmd {
+	client := m.client
+	index := op.next
+	w := op.works[index]
+	op.next++
+	op.inFlight++
+	return func() tea.Msg {
+		if op.kind == bulkDelete {
+			err := client.DeleteResourceBundleByID(context.Background(), w.ID, w.Version)
+			return bulkItemMsg{op: op, index: index, err: err}
+		}
+		_, raw, err := client.GetResourceBundleDetailsHTTP(op.ctx, w.ID, op.consumer)
+		return bulkItemMsg{op: op, index: index, raw: raw, err: err}
+	}
+}
+
+// bulkListed starts fetching the ManifestWorks an export listed.
+func (m *Model) bulkListed(msg bulkListedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.failBulk(msg.err)
+		return nil
+	}
+	msg.op.works = msg.works
+	if len(msg.works) == 0 {
+		return m.finishBulk()
+	}
+	return m.bulkStepCmd(msg.op)
+}
+
+// bulkItemDone records the outcome of one ManifestWork and sends the next
+// request in its place, unless the operation is over. An export stops at the
+// first error, as an incomplete copy would be misleading; a delete carries on
+// and reports the failures at the end, once the last request has finished.
+func (m *Model) bulkItemDone(msg bulkItemMsg) tea.Cmd {
+	op := msg.op
+	w := op.works[msg.index]
+	op.done++
+	op.inFlight--
+	switch {
+	case msg.err != nil && op.kind == bulkExport:
+		m.failBulk(msg.err)
+		return nil
+	case msg.err != nil:
+		op.failures = append(op.failures, fmt.Sprintf("%s: %v", w.Name, msg.err))
+		op.last = w.Name + " failed"
+	case op.kind == bulkExport:
+		op.bundles = append(op.bundles, m.exportedBundle(msg.raw))
+	default:
+		op.last = w.Name + " deleted"
+	}
+	if op.next < len(op.works) && !op.cancelled {
+		return m.bulkStepCmd(op)
+	}
+	if op.inFlight > 0 {
+		return nil
+	}
+	return m.finishBulk()
+}
+
+// exportedBundle returns raw as an export copies it: with the values of its
+// Secret manifests redacted, unless they are revealed in the detail panel.
+func (m Model) exportedBundle(raw map[string]interface{}) map[string]interface{} {
+	if id, _ := raw["id"].(string); id != "" && id == m.secretsShownID {
+		return raw
+	}
+	redacted, _ := redactSecrets(raw, false)
+	return redacted
+}
+
+// failBulk ends an export that could not complete.
+func (m *Model) failBulk(err error) {
+	m.bulk.cancel()
+	m.bulk = nil
+	m.loading = false
+	m.statusMsg = ""
+	m.errMsg2 = err.Error()
+}
+
+// finishBulk closes the progress modal: an export copies what it fetched, a
+// delete reports how many ManifestWorks went and reloads the list.
+func (m *Model) finishBulk() tea.Cmd {
+	op := m.bulk
+	m.bulk = nil
+	if op.kind == bulkExport {
+		// Ctrl+C still cancels a clipboard that does not answer
+		m.consumerCopyCancel = op.cancel
+		m.statusMsg = fmt.Sprintf("Copying %d ManifestWork bundles of %s…", len(op.bundles), op.consumer)
+		return copyBundlesCmd(op)
+	}
+
+	op.cancel()
+	m.loading = false
+	deleted := op.done - len(op.failures)
+	m.statusMsg = fmt.Sprintf("Deleted %d of %d ManifestWorks of %s", deleted, len(op.works), op.consumer)
+	if op.done < len(op.works) {
+		m.statusMsg += fmt.Sprintf(" (cancelled, %d not attempted)", len(op.works)-op.done)
+	}
+	m.errMsg2 = ""
+	if len(op.failures) > 0 {
+		m.errMsg2 = fmt.Sprintf("%d delete(s) failed; first: %s", len(op.failures), op.failures[0])
+	}
+	if deleted == 0 {
+		return nil
+	}
+	m.pinnedManifestID, m.pinnedConsumer = "", ""
+	m.setDetailContent("")
+	m.viewport.SetContent("")
+	if m.activeConsumer() != op.consumer {
+		return nil
+	}
+	m.loading = true
+	return m.loadManifests(op.consumer)
+}
+
+// cancelBulk handles Esc in the progress modal. An export is abandoned at
+// once and copies nothing; a delete waits for the requests in flight, which
+// cannot be called back, and then reports how many ManifestWorks went.
+func (m *Model) cancelBulk() {
+	op := m.bulk
+	if op.kind == bulkExport {
+		op.cancel()
+		m.bulk = nil
+		m.loading = false
+		m.statusMsg = fmt.Sprintf("Export cancelled after %d of %d ManifestWorks; nothing copied",
+			op.done, len(op.works))
+		return
+	}
+	op.cancelled = true
+	m.statusMsg = fmt.Sprintf("Cancelling — waiting for the %d delete(s) in flight", op.inFlight)
+}
+
+// copyBundlesCmd copies the resource bundles an export fetched, with their
+// Secret values redacted, to the clipboard as one JSON array.
+func copyBundlesCmd(op *bulkOperation) tea.Cmd {
+	return func() tea.Msg {
+		data, err := json.MarshalIndent(op.bundles, "", "  ")
+		if err != nil {
+			return consumerCopiedMsg{err: err}
+		}
+		if err := writeClipboard(op.ctx, string(data), clipboardTimeout); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return consumerCopiedMsg{err: err}
+			}
+			return consumerCopiedMsg{err: fmt.Errorf("clipboard: %w", err)}
+		}
+		op.cancel()
+		return consumerCopiedMsg{
+			label: fmt.Sprintf("%d ManifestWork bundles of %s as JSON", len(op.bundles), op.consumer),
+			size:  len(data),
+		}
+	}
+}
+
+func (m Model) handleBulkKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEscape && !m.bulk.cancelled {
+		m.cancelBulk()
+	}
+	return m, nil
+}
+
+// viewBulkModal renders the progress of the running bulk operation:
+//
+//	Exporting ManifestWorks of agent1
+//
+//	[##############----------------------] 4/10
+//	web-app deleted
+//
+//	[Esc] cancel
+func (m Model) viewBulkModal() string {
+	op := m.bulk
+	title := "Exporting ManifestWorks of " + op.consumer
+	if op.kind == bulkDelete {
+		title = "Deleting ManifestWorks of " + op.consumer
+	}
+
+	percent := 0.0
+	if len(op.works) > 0 {
+		percent = float64(op.done) / float64(len(op.works))
+	}
+	count := fmt.Sprintf("%d/%d", op.done, len(op.works))
+	current := "Listing ManifestWorks…"
+	switch {
+	case op.works == nil:
+		count = "…"
+	case op.kind == bulkDelete:
+		current = op.last
+	case op.done < len(op.works):
+		current = op.works[op.done].Name
+	default:
+		current = ""
+	}
+
+	lines := []string{
+		styleModalTitle.Render(title),
+		"",
+		"[" + styleStatusOK.Render(op.bar.ViewAs(percent)) + "] " + styleDetailValue.Render(count),
+		styleHelpDesc.Render(runewidth.Truncate(current, bulkBarWidth+2, "…")),
+	}
+	if len(op.failures) > 0 {
+		lines = append(lines, styleStatusErr.Render(fmt.Sprintf("%d failed", len(op.failures))))
+	}
+	help := "[Esc] cancel"
+	if op.cancelled {
+		help = fmt.Sprintf("Cancelling after %d delete(s) in flight…", op.inFlight)
+	}
+	lines = append(lines, "", styleHelpDesc.Render(help))
+	return styleModal.Width(50).Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+func TestBulkDeleteCancelled(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	if err != nil {
+		t.Fatalf("failed to load demo fixtures: %v", err)
+	}
+	// Twice the demo ManifestWorks of the first consumer, more than are sent at once
+	consumer := fixtures.Consumers[0].Name
+	for _, d := range slices.Clone(fixtures.ManifestWorks) {
+		if d.ConsumerName == consumer {
+			d.ID, d.Name = d.ID+"-copy", d.Name+"-copy"
+			fixtures.ManifestWorks = append(fixtures.ManifestWorks, d)
+		}
+	}
+	works, err := fixtures.ListManifestWorksHTTP(context.Background(), consumer)
+	if err != nil || len(works) <= bulkDeleteConcurrency {
+		t.Fatalf("expected more than %d ManifestWorks on %s, got %d (%v)",
+			bulkDeleteConcurrency, consumer, len(works), err)
+	}
+	m := New(maestro.ClientConfig{}, Options{Fixtures: fixtures})
+	m.screen = screenMain
+	m.width, m.height = 120, 40
+
+	// The first command of the batch is the spinner tick
+	batch, ok := m.startBulkDelete(consumer, works)().(tea.BatchMsg)
+	if !ok || len(batch) != 1+bulkDeleteConcurrency {
+		t.Fatalf("expected the spinner and %d deletes in flight, got %d commands", bulkDeleteConcurrency, len(batch))
+	}
+	inFlight := batch[1:]
+
+	// Each delete that finishes is counted and replaced by the next one
+	first := inFlight[0]().(bulkItemMsg)
+	inFlight = append(inFlight[1:], m.bulkItemDone(first))
+	view := stripANSI(m.View())
+	if expected := fmt.Sprintf("] 1/%d", len(works)); !strings.Contains(view, "Deleting ManifestWorks of "+consumer) ||
+		!strings.Contains(view, "[#") || !strings.Contains(view, expected) ||
+		!strings.Contains(view, works[first.index].Name+" deleted") {
+		t.Fatalf("expected the progress modal at %s, got:\n%s", expected, view)
+	}
+
+	// Esc lets the deletes in flight finish, then stops
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEscape})
+	m = updated.(Model)
+	if m.bulk == nil || !m.bulk.cancelled || m.bulk.inFlight != bulkDeleteConcurrency {
+		t.Fatal("expected the operation to wait for the deletes in flight")
+	}
+	attempted := 1 + bulkDeleteConcurrency
+	for _, cmd := range inFlight {
+		m.bulkItemDone(cmd().(bulkItemMsg))
+		if m.bulk != nil && m.bulk.next != attempted {
+			t.Fatal("expected no new delete after Esc")
+		}
+	}
+	expected := fmt.Sprintf("Deleted %d of %d ManifestWorks of %s (cancelled, %d not attempted)",
+		attempted, len(works), consumer, len(works)-attempted)
+	if m.bulk != nil || m.statusMsg != expected {
+		t.Errorf("expected %q, got %q (modal open: %v)", expected, m.statusMsg, m.bulk != nil)
+	}
+	if left, _ := fixtures.ListManifestWorksHTTP(context.Background(), consumer); len(left) != len(works)-attempted {
+		t.Errorf("expected %d ManifestWorks left, got %d", len(works)-attempted, len(left))
+	}
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"context"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+// Client is the part of the Maestro API the TUI uses. *maestro.Client
+// implements it against a server and *Fixtures against offline data; tests
+// can inject their own through Options.Client.
+type Client interface {
+	ListConsumersWithDetails(ctx context.Context) ([]maestro.ConsumerInfo, error)
+	// ListConsumersPage returns one page of consumers, numbered from 1, and
+	// how many consumers there are in total.
+	ListConsumersPage(ctx context.Context, page int) ([]maestro.ConsumerInfo, int, error)
+	ListManifestWorksHTTP(ctx context.Context, consumer string) ([]maestro.ResourceBundleSummary, error)
+	// GetResourceBundleDetailsHTTP returns the ManifestWork with the given ID
+	// and its raw form for the JSON/YAML views.
+	GetResourceBundleDetailsHTTP(
+		ctx context.Context,
+		id, consumer string,
+	) (*maestro.ManifestWorkDetails, map[string]interface{}, error)
+	CreateConsumer(ctx context.Context, name string, labels map[string]string) (*maestro.ConsumerInfo, error)
+	UpdateConsumerLabels(ctx context.Context, id string, labels map[string]string) (*maestro.ConsumerInfo, error)
+	DeleteConsumer(ctx context.Context, id string) error
+	DeleteResourceBundleByID(ctx context.Context, id string, expectedVersion int32) error
+}
+
+var (
+	_ Client = (*maestro.Client)(nil)
+	_ Client = (*Fixtures)(nil)
+)
@@ -0,0 +1,181 @@
+package tui
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+)
+
+//go:embed demo_fixtures.json
+var demoFixturesJSON []byte
+
+// Fixtures is offline data for demo mode: the TUI reads consumers and
+// ManifestWorks from it instead of a Maestro server. Creates and deletes only
+// change the in-memory copy. It implements Client.
+type Fixtures struct {
+	Consumers     []maestro.ConsumerInfo        `json:"consumers"`
+	ManifestWorks []maestro.ManifestWorkDetails `json:"manifestWorks"`
+
+	mu sync.Mutex
+}
+
+// DemoFixtures returns the built-in demo data set.
+func DemoFixtures() (*Fixtures, error) {
+	return parseFixtures(demoFixturesJSON)
+}
+
+// LoadFixtures reads a fixtures file: a JSON object with "consumers" (as in
+// ConsumerInfo) and "manifestWorks" (as in ManifestWorkDetails).
+func LoadFixtures(path string) (*Fixtures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+	return parseFixtures(data)
+}
+
+func parseFixtures(data []byte) (*Fixtures, error) {
+	f := &Fixtures{}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("failed to parse fixtures: %w", err)
+	}
+	return f, nil
+}
+
+// ListConsumersWithDetails returns the fixture consumers.
+func (f *Fixtures) ListConsumersWithDetails(context.Context) ([]maestro.ConsumerInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]maestro.ConsumerInfo(nil), f.Consumers...), nil
+}
+
+// ListConsumersPage returns the fixture consumers as a single page.
+func (f *Fixtures) ListConsumersPage(ctx context.Context, page int) ([]maestro.ConsumerInfo, int, error) {
+	if page > 1 {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		return nil, len(f.Consumers), nil
+	}
+	consumers, err := f.ListConsumersWithDetails(ctx)
+	return consumers, len(consumers), err
+}
+
+// ListManifestWorksHTTP returns the consumer's ManifestWorks in the shape the
+// list API returns.
+func (f *Fixtures) ListManifestWorksHTTP(_ context.Context, consumer string) ([]maestro.ResourceBundleSummary, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []maestro.ResourceBundleSummary
+	for _, d := range f.ManifestWorks {
+		if d.ConsumerName != consumer {
+			continue
+		}
+		out = append(out, maestro.ResourceBundleSummary{
+			ID:            d.ID,
+			Name:          d.Name,
+			ConsumerName:  d.ConsumerName,
+			Version:       d.Version,
+			CreatedAt:     d.CreatedAt,
+			UpdatedAt:     d.UpdatedAt,
+			DeletedAt:     d.DeletedAt,
+			ManifestCount: len(d.Manifests),
+			Manifests:     d.Manifests,
+			Conditions:    d.Conditions,
+		})
+	}
+	return out, nil
+}
+
+// GetResourceBundleDetailsHTTP returns the ManifestWork with the given ID and
+// its raw form for the JSON/YAML views.
+func (f *Fixtures) GetResourceBundleDetailsHTTP(
+	_ context.Context,
+	id, _ string,
+) (*maestro.ManifestWorkDetails, map[string]interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range f.ManifestWorks {
+		if f.ManifestWorks[i].ID != id {
+			continue
+		}
+		d := f.ManifestWorks[i]
+		data, err := json.Marshal(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		var raw map[string]interface{}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, nil, err
+		}
+		return &d, raw, nil
+	}
+	return nil, nil, fmt.Errorf("resource bundle %s not found in fixtures", id)
+}
+
+// CreateConsumer adds a consumer to the in-memory copy.
+func (f *Fixtures) CreateConsumer(
+	_ context.Context,
+	name string,
+	labels map[string]string,
+) (*maestro.ConsumerInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, c := range f.Consumers {
+		if c.Name == name {
+			return nil, fmt.Errorf("consumer %q already exists", name)
+		}
+	}
+	info := maestro.ConsumerInfo{ID: "demo-" + name, Name: name, Labels: labels}
+	f.Consumers = append(f.Consumers, info)
+	return &info, nil
+}
+
+// UpdateConsumerLabels replaces a consumer's labels in the in-memory copy.
+func (f *Fixtures) UpdateConsumerLabels(
+	_ context.Context,
+	id string,
+	labels map[string]string,
+) (*maestro.ConsumerInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range f.Consumers {
+		if f.Consumers[i].ID == id {
+			f.Consumers[i].Labels = labels
+			info := f.Consumers[i]
+			return &info, nil
+		}
+	}
+	return nil, fmt.Errorf("consumer %s not found in fixtures", id)
+}
+
+// DeleteConsumer removes a consumer from the in-memory copy.
+func (f *Fixtures) DeleteConsumer(_ context.Context, id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, c := range f.Consumers {
+		if c.ID == id {
+			f.Consumers = append(f.Consumers[:i], f.Consumers[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("consumer %s not found in fixtures", id)
+}
+
+// DeleteResourceBundleByID removes a ManifestWork from the in-memory copy.
+// Nothing else changes fixtures, so the version always matches.
+func (f *Fixtures) DeleteResourceBundleByID(_ context.Context, id string, _ int32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, d := range f.ManifestWorks {
+		if d.ID == id {
+			f.ManifestWorks = append(f.ManifestWorks[:i], f.ManifestWorks[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("resource bundle %s not found in fixtures", id)
+}
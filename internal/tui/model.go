@@ -2,20 +2,30 @@ package tui
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
 	sigyaml "sigs.k8s.io/yaml"
 
 	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
 )
 
 // ─── Screen / panel states ────────────────────────────────────────────────────
@@ -63,32 +73,111 @@ func (m detailViewMode) next() detailViewMode {
 // ─── Message types ────────────────────────────────────────────────────────────
 
 type errMsg struct{ err error }
+type detailErrMsg struct{ err error } // a detail (re)load failed; the previous content is kept
 type connectedMsg struct {
-	client    *maestro.Client
-	consumers []maestro.ConsumerInfo
+	client    Client
+	consumers []maestro.ConsumerInfo // the first page
+	total     int                    // consumers the server reports
+}
+type consumersLoadedMsg struct {
+	consumers []maestro.ConsumerInfo // the first page
+	total     int                    // consumers the server reports
 }
-type consumersLoadedMsg struct{ consumers []maestro.ConsumerInfo }
 type manifestsLoadedMsg struct {
+	consumer  string
 	manifests []maestro.ResourceBundleSummary
 }
 type detailLoadedMsg struct {
 	detail   *maestro.ManifestWorkDetails
+	raw      map[string]interface{}
 	jsonData string // syntax-colored
 	yamlData string // syntax-colored
 	rawJSON  string // plain, for clipboard
 	rawYAML  string // plain, for clipboard
 }
 type consumerCreatedMsg struct{ consumer maestro.ConsumerInfo }
+type consumerUpdatedMsg struct{ consumer maestro.ConsumerInfo }
 type consumerDeletedMsg struct{}
 type manifestDeletedMsg struct{}
 type watchTickMsg time.Time
+
+// staleTickMsg moves the clock of the stale badge on while no watch ticks do.
+type staleTickMsg time.Time
+
+// listWatchTickMsg starts a list-watch refresh; gen drops ticks scheduled
+// before the list watch was last turned on, so toggling W never leaves two
+// tick chains running.
+type listWatchTickMsg struct {
+	gen int
+	at  time.Time
+}
+
+// idleRefreshTickMsg starts a background refresh; gen drops ticks scheduled
+// before the last connect.
+type idleRefreshTickMsg struct{ gen int }
+
+// consumerPageMsg carries a further page of a paginated consumer list; gen
+// drops pages of a list that was loaded again since.
+type consumerPageMsg struct {
+	gen       int
+	page      int
+	consumers []maestro.ConsumerInfo
+	total     int
+	err       error
+}
+
+// consumersRefreshedMsg carries a background consumer-list refresh; unlike
+// consumersLoadedMsg it keeps the selected consumer.
+type consumersRefreshedMsg struct {
+	consumers []maestro.ConsumerInfo
+	err       error
+}
+
+// manifestsRefreshedMsg carries a list-watch refresh; unlike manifestsLoadedMsg it keeps the selection.
+type manifestsRefreshedMsg struct {
+	consumer  string
+	manifests []maestro.ResourceBundleSummary
+	err       error
+}
+
 type spinnerTickMsg time.Time
-type clipboardMsg struct{ err error }
+type clipboardMsg struct {
+	err   error
+	label string // what was copied, for the status line; "" means the whole view
+	size  int    // bytes written
+}
+
+// consumerCopiedMsg reports the end of copying a consumer's ManifestWorks.
+type consumerCopiedMsg struct {
+	err   error
+	label string
+	size  int
+}
+
+// statusEvent is one entry in the event log: a status or error message and when it was raised.
+type statusEvent struct {
+	at    time.Time
+	text  string
+	isErr bool
+}
+
+// maxEvents bounds the event log; the oldest entries are dropped first.
+const maxEvents = 200
+
+// maxRecentlyViewed bounds the jump list walked back with the b key.
+const maxRecentlyViewed = 20
+
+// viewedWork identifies a ManifestWork opened in the detail panel.
+type viewedWork struct {
+	consumer string
+	id       string
+	name     string
+}
 
 // searchMatch records the position of one search hit within the detail content.
 type searchMatch struct {
 	line  int // 0-indexed line number in the rendered content
-	start int // visual char offset within that line (plain text)
+	start int // byte offset within that line's plain text, on a rune boundary
 	end   int // exclusive end
 }
 
@@ -104,9 +193,13 @@ type Model struct {
 	connectInsecure bool
 	connectFocusIdx int
 	connectLoading  bool
+	// Configured endpoints with their probe results; probeGen is bumped on each
+	// probe round so results from an earlier round are dropped.
+	connectEndpoints []endpointProbe
+	probeGen         int
 
 	// Main
-	client       *maestro.Client
+	client       Client // nil until connected
 	clientConfig maestro.ClientConfig
 	focused      focusedPanel
 
@@ -114,24 +207,45 @@ type Model struct {
 	consumers      []maestro.ConsumerInfo
 	consumerCursor int
 	consumerOffset int
+	// Health rollup by consumer name, from the consumer's last loaded ManifestWork list
+	consumerHealth map[string]consumerHealth
 
 	// ManifestWorks
 	manifests      []maestro.ResourceBundleSummary
 	manifestCursor int
 	manifestOffset int
+	wrapNames      bool // wrap long ManifestWork names onto a second row instead of truncating them
 	filterInput    textinput.Model
 	filtering      bool
 	filterText     string
+	// Baseline of each consumer's ManifestWork conditions (by consumer name),
+	// taken when its list is first loaded and kept until r starts a new one;
+	// changedOnly hides works that still match it.
+	baselines   map[string]*conditionBaseline
+	changedOnly bool
+	sortBy      string // one of maestro.SortKeys
+	sortReverse bool
 
 	// Detail
 	viewport        viewport.Model
-	detailContent   string // rendered content for current view mode
-	detailFormatted string // formatted (pretty) view
-	detailJSON      string // syntax-colored JSON
-	detailYAML      string // syntax-colored YAML
-	detailRawJSON   string // plain JSON (for clipboard)
-	detailRawYAML   string // plain YAML (for clipboard)
+	detailContent   string   // rendered content for current view mode; set via setDetailContent
+	detailLines     []string // detailContent split into lines
+	detailPlain     []string // detailLines with ANSI codes stripped
+	detailCharMaps  [][]int  // buildCharMap of each detail line, filled in on first use
+	detailFormatted string   // formatted (pretty) view
+	detailJSON      string   // syntax-colored JSON
+	detailYAML      string   // syntax-colored YAML
+	detailRawJSON   string   // plain JSON (for clipboard)
+	detailRawYAML   string   // plain YAML (for clipboard)
+	detailRaw       map[string]interface{}
+	detail          *maestro.ManifestWorkDetails
+	detailScale     string // size and resource count shown in the detail title, e.g. "12 KB · 3 resources"
 	detailViewMode  detailViewMode
+	revealBinary    bool   // show binary/oversized values instead of placeholders
+	collapseArrays  bool   // show long scalar arrays as "[ N items ]" placeholders
+	secretsShownID  string // detail whose Secret values are decoded; "" keeps them redacted
+	wideConditions  bool   // formatted view shows each condition's full JSON inline
+	detailErrorLine int    // line of the first failing condition in detailFormatted, or -1
 
 	// Search within detail viewport
 	searchInput   textinput.Model
@@ -140,29 +254,180 @@ type Model struct {
 	searchMatches []searchMatch
 	searchCurrent int // index into searchMatches
 
+	// Search peek: the matching lines with context, as a grep-like list
+	showSearchPeek bool
+	peekCursor     int // index into peekLines()
+	peekOffset     int // first entry shown
+	// searchHighlighted is detailLines with the search highlights injected, so
+	// moving between matches only re-renders the lines that changed.
+	searchHighlighted []string
+
 	// Watch
-	watching bool
+	watching       bool      // re-fetch the selected ManifestWork's detail
+	watchingList   bool      // re-fetch the whole ManifestWork list so the status icons update
+	listRefreshing bool      // a list-watch refresh is in flight
+	listWatchGen   int       // generation of the list-watch ticks, bumped when W turns it on
+	idleRefreshGen int       // generation of the background refresh ticks, bumped on connect
+	detailLoadedAt time.Time // when the displayed detail was last loaded successfully
+	detailFailed   bool      // the last detail refresh failed and the content is left over
+	staleTicking   bool      // a staleTick is pending
+	watchFailures  int       // consecutive failed watch refreshes; >0 shows the reconnecting indicator
+	lastClickAt    time.Time // time of the last plain click in the detail panel (double-click detection)
+	lastClickLine  int       // content line of that click
+	selecting      bool      // left button is held after a press in the detail content
+	selDragged     bool      // the pointer moved since the press, so a selection is shown
+	selAnchor      cellPos   // where the drag started
+	selHead        cellPos   // where the pointer is now
+	now            time.Time // clock reading from the most recent spinner/watch tick
+	watchedKey     string    // consumer/name of the detail whose health is tracked below
+	watchedHealth  string    // "Healthy" | "Degraded" | "" (no conditions yet)
+	alertMsg       string    // highlighted transition notice, cleared on the next key press
+
+	// Recently viewed ManifestWorks, oldest first; the last entry is the one shown.
+	recentlyViewed    []viewedWork
+	pendingSelectID   string // ManifestWork to select once the list being loaded arrives
+	pendingSelectName string // name to re-select once another consumer's list arrives
 
 	// Modals — create consumer
 	showCreateConsumer bool
 	createInput        textinput.Model
+	createLabelsInput  textinput.Model
+	createFocusIdx     int    // 0 = name, 1 = labels
+	editConsumerID     string // set when the modal edits this consumer's labels instead of creating one
+
+	// Modals — consumer info
+	showConsumerInfo bool
+
+	// Modals — event log
+	showEventLog   bool
+	eventLogOffset int // scroll position, counted from the newest entry
+
+	// Bookmarked viewport offsets of the detail shown (bookmarkID), per view
+	// mode, in the order they were set
+	bookmarks  []detailBookmark
+	bookmarkID string
+
+	// Modals — status icon legend
+	showLegend bool
+
+	// pinnedManifestID, when set, keeps the detail panel on that ManifestWork
+	// (of pinnedConsumer) while the list cursor moves; "" follows the cursor.
+	pinnedManifestID string
+	pinnedConsumer   string
 
 	// Modals — confirm delete
 	showConfirm bool
 	confirmKind string // "consumer" | "manifest"
 	confirmID   string
+	confirmVer  int32 // ManifestWork version shown when the delete was requested
 	confirmName string
 	confirmMsg  string
+	// confirmWorks are the ManifestWorks of confirmConsumer a "manifests"
+	// (bulk) delete removes.
+	confirmWorks    []maestro.ResourceBundleSummary
+	confirmConsumer string
+
+	// clockSkewWarned is set once a creation time ahead of the local clock has
+	// been reported, so the warning is not repeated for every list.
+	clockSkewWarned bool
+
+	// consumerCopyCancel cancels the copy of a consumer's ManifestWorks while
+	// it is fetching; nil when none is running.
+	consumerCopyCancel context.CancelFunc
+
+	// consumersTotal is how many consumers the server reports; above
+	// len(consumers) while further pages load, one after the other, under
+	// consumerPageGen.
+	consumersTotal  int
+	consumerPageGen int
+
+	// bulk is the bulk export or delete shown in the progress modal; nil when
+	// none is running.
+	bulk *bulkOperation
 
 	// Status
 	loading    bool
 	statusMsg  string
-	errMsg2    string // renamed to avoid clash with errMsg type
+	errMsg2    string    // renamed to avoid clash with errMsg type
+	statusAt   time.Time // when the current status/error message was raised
+	events     []statusEvent
 	spinnerIdx int
+	themeIdx   int // index into themes
+
+	opts Options
+}
+
+// DefaultClipboardWarnSize is the default Options.ClipboardWarnSize: 1 MiB.
+const DefaultClipboardWarnSize = 1 << 20
+
+// MinIdleRefresh is the shortest Options.IdleRefresh accepted by the tui
+// command: the refresh is meant to be occasional, not another watch.
+const MinIdleRefresh = 30 * time.Second
+
+// Options controls optional TUI behavior.
+type Options struct {
+	// StatusTimestamps prefixes the status line with the time the message was raised.
+	StatusTimestamps bool
+	// Theme names the initial color theme (see ThemeNames); empty means the default.
+	Theme string
+	// Fixtures, when set, replaces the Maestro server with offline data and skips
+	// the connect screen.
+	Fixtures *Fixtures
+	// Client, when set and Fixtures is not, is used instead of connecting to
+	// a Maestro server, skipping the connect screen; tests inject fakes here.
+	Client Client
+	// DetailView selects the initial detail view: "json", "yaml" or "table"
+	// (formatted). Empty or unknown values mean formatted.
+	DetailView string
+	// ScrollToError opens a loaded detail in the formatted view at its first
+	// failing condition instead of the top.
+	ScrollToError bool
+	// Endpoints are offered on the connect screen, each probed in the
+	// background and shown as reachable or not.
+	Endpoints []string
+	// Layout arranges the main screen: "split", "stacked" or "auto" (see
+	// LayoutNames). Empty means auto.
+	Layout string
+	// AlwaysShowConsumers keeps the consumers panel in the split layout when
+	// there is a single consumer. Otherwise the panel is left out until it is
+	// focused, and the ManifestWorks panel takes its height.
+	AlwaysShowConsumers bool
+	// ClipboardWarnSize is the size in bytes above which a copy warns that it
+	// may be slow to paste or truncated by clipboard managers; 0 never warns.
+	ClipboardWarnSize int
+	// ShowSecrets allows revealing the decoded values of Secret manifests in
+	// the JSON and YAML views, one ManifestWork at a time; they are redacted
+	// as *** otherwise.
+	ShowSecrets bool
+	// AuditLogger records each Secret reveal with the consumer, ManifestWork
+	// name and ID. It should write somewhere that outlasts the session and is
+	// off the screen, such as the --audit-log file; the client logger is used
+	// when it is nil.
+	AuditLogger *logger.Logger
+	// IdleRefresh, when positive, reloads the consumer list and the shown
+	// ManifestWork list this often in the background so a long session does
+	// not go stale, keeping the selection. 0 disables it.
+	IdleRefresh time.Duration
+	// Deterministic renders View without colors, with a fixed spinner frame
+	// and without the time-relative "stable for" badge, so the output does not
+	// depend on the terminal or on timing. It is meant for golden-file tests.
+	Deterministic bool
+}
+
+// parseDetailViewMode maps an output format name to the matching detail view.
+func parseDetailViewMode(name string) detailViewMode {
+	switch strings.ToLower(name) {
+	case "json":
+		return viewModeJSON
+	case "yaml":
+		return viewModeYAML
+	default:
+		return viewModeFormatted
+	}
 }
 
 // New creates a new Model pre-populated from the given ClientConfig.
-func New(config maestro.ClientConfig) Model {
+func New(config maestro.ClientConfig, opts Options) Model {
 	// Endpoint input
 	ep := textinput.New()
 	ep.Placeholder = "http://localhost:8000"
@@ -188,35 +453,66 @@ func New(config maestro.ClientConfig) Model {
 	ci.Placeholder = "consumer name"
 	ci.Width = 30
 
+	// Create consumer labels input
+	cl := textinput.New()
+	cl.Placeholder = "region=us-west,env=prod (optional)"
+	cl.Width = 30
+
 	// Detail search input
 	si := textinput.New()
 	si.Placeholder = "search..."
 	si.Width = 30
 	si.Prompt = "/ "
 
+	themeIdx := themeIndex(opts.Theme)
+	if themeIdx < 0 {
+		themeIdx = 0
+	}
+	applyTheme(themes[themeIdx])
+
 	vp := viewport.New(60, 20)
 	vp.Style = lipgloss.NewStyle()
 
 	return Model{
-		screen:        screenConnect,
-		connectInputs: [2]textinput.Model{ep, tok},
-		clientConfig:  config,
-		focused:       panelConsumers,
-		filterInput:   fi,
-		createInput:   ci,
-		searchInput:   si,
-		viewport:      vp,
+		screen:            screenConnect,
+		connectInputs:     [2]textinput.Model{ep, tok},
+		clientConfig:      config,
+		focused:           panelConsumers,
+		filterInput:       fi,
+		createInput:       ci,
+		createLabelsInput: cl,
+		searchInput:       si,
+		connectEndpoints:  newEndpointProbes(opts.Endpoints),
+		consumerHealth:    map[string]consumerHealth{},
+		baselines:         map[string]*conditionBaseline{},
+		sortBy:            maestro.SortByName,
+		detailViewMode:    parseDetailViewMode(opts.DetailView),
+		viewport:          vp,
+		themeIdx:          themeIdx,
+		opts:              opts,
+		client:            opts.client(),
+	}
+}
+
+// client returns the Client given in place of a server connection, if any.
+func (opts Options) client() Client {
+	if opts.Fixtures != nil {
+		return opts.Fixtures
 	}
+	return opts.Client
 }
 
 // ─── Init ─────────────────────────────────────────────────────────────────────
 
 // Init implements tea.Model. It starts the spinner and text-input blink ticks.
 func (m Model) Init() tea.Cmd {
-	return tea.Batch(
-		textinput.Blink,
-		spinnerTick(),
-	)
+	cmds := []tea.Cmd{textinput.Blink, spinnerTick()}
+	if m.client != nil {
+		cmds = append(cmds, connectClientCmd(m.client))
+	} else if len(m.connectEndpoints) > 0 {
+		cmds = append(cmds, probeEndpointsCmd(m.connectEndpoints, m.probeGen, m.probeConfig()))
+	}
+	return tea.Batch(cmds...)
 }
 
 // ─── Update ───────────────────────────────────────────────────────────────────
@@ -224,6 +520,11 @@ func (m Model) Init() tea.Cmd {
 // Update implements tea.Model. It routes messages to the appropriate handler.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
+	prevStatus, prevErr := m.statusMsg, m.errMsg2
+
+	if key, ok := msg.(tea.KeyMsg); ok && key.Paste {
+		msg = cleanPaste(key)
+	}
 
 	// ── 1. Always forward every message to the active sub-component first.
 	// This lets text inputs receive character keys, blink ticks, etc. before
@@ -238,9 +539,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case screenMain:
 		switch {
 		case m.showCreateConsumer:
-			updated, cmd := m.createInput.Update(msg)
-			m.createInput = updated
-			cmds = append(cmds, cmd)
+			if m.createFocusIdx == 1 {
+				updated, cmd := m.createLabelsInput.Update(msg)
+				m.createLabelsInput = updated
+				cmds = append(cmds, cmd)
+			} else {
+				updated, cmd := m.createInput.Update(msg)
+				m.createInput = updated
+				cmds = append(cmds, cmd)
+			}
 		case m.filtering:
 			prevFilter := m.filterText
 			updated, cmd := m.filterInput.Update(msg)
@@ -251,6 +558,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.manifestOffset = 0
 			}
 			cmds = append(cmds, cmd)
+		case m.showSearchPeek:
+			// The peek list takes the keys; they must not edit the query behind it
 		case m.searching:
 			prevText := m.searchText
 			updated, cmd := m.searchInput.Update(msg)
@@ -279,17 +588,62 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.viewport.SetContent(m.detailContent)
 
 	case spinnerTickMsg:
+		m.now = time.Time(msg)
 		if m.loading || m.connectLoading {
 			m.spinnerIdx = (m.spinnerIdx + 1) % len(spinnerFrames)
 			cmds = append(cmds, spinnerTick())
 		}
 
+	case endpointProbedMsg:
+		if msg.gen == m.probeGen {
+			for i := range m.connectEndpoints {
+				if m.connectEndpoints[i].url != msg.url {
+					continue
+				}
+				m.connectEndpoints[i].state = probeReachable
+				if msg.err != nil {
+					m.connectEndpoints[i].state = probeUnreachable
+					m.connectEndpoints[i].err = msg.err.Error()
+				}
+			}
+		}
+
 	case errMsg:
 		m.loading = false
 		m.connectLoading = false
 		m.errMsg2 = msg.err.Error()
 		m.statusMsg = ""
 
+	case detailErrMsg:
+		m.loading = false
+		m.now = time.Now()
+		if m.detailContent != "" {
+			m.detailFailed = true
+		}
+		if !m.watching {
+			m.errMsg2 = msg.err.Error()
+			m.statusMsg = ""
+			// Without watch ticks the stale badge needs its own to keep counting
+			if m.detailFailed && !m.staleTicking {
+				m.staleTicking = true
+				cmds = append(cmds, staleTick())
+			}
+			break
+		}
+		// Keep polling, backing off, so a network blip does not end the watch
+		// and it recovers on its own once the server is reachable again. Only
+		// an outage that outlasts several attempts is reported as an error.
+		m.watchFailures++
+		delay := watchRetryDelay(m.watchFailures)
+		if m.watchFailures >= maxWatchFailures {
+			m.errMsg2 = fmt.Sprintf("watch refresh failed %d times, retrying every %s: %v",
+				m.watchFailures, delay, msg.err)
+			m.statusMsg = ""
+		} else {
+			m.statusMsg = fmt.Sprintf("Reconnecting… retry %d/%d in %s", m.watchFailures, maxWatchFailures-1, delay)
+		}
+		cmds = append(cmds, watchTickAfter(delay))
+
 	case connectedMsg:
 		m.client = msg.client
 		m.consumers = msg.consumers
@@ -299,14 +653,29 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.connectLoading = false
 		m.loading = false
 		m.statusMsg = fmt.Sprintf("Connected — %d consumer(s)", len(m.consumers))
+		m.idleRefreshGen++
+		if m.opts.IdleRefresh > 0 {
+			cmds = append(cmds, idleRefreshTick(m.opts.IdleRefresh, m.idleRefreshGen))
+		}
+		if m.opts.Fixtures != nil {
+			m.statusMsg = fmt.Sprintf("Demo data — %d consumer(s)", len(m.consumers))
+		}
 		m.errMsg2 = ""
-		if len(m.consumers) > 0 {
+		if len(m.consumers) == 0 {
+			// A server without consumers is not a failed connection; say so
+			m.statusMsg = "Connected — no consumers yet, press [n] to create one"
+			if m.opts.Fixtures != nil {
+				m.statusMsg = "Demo data — no consumers, press [n] to create one"
+			}
+			m.clearManifests()
+		} else {
 			// With a single consumer skip the consumers panel and land on manifests
-			if len(m.consumers) == 1 {
+			if len(m.consumers) == 1 && msg.total <= 1 {
 				m.focused = panelManifests
 			}
 			cmds = append(cmds, m.loadManifests(m.consumers[0].Name))
 		}
+		cmds = append(cmds, m.loadMoreConsumers(msg.total))
 
 	case consumersLoadedMsg:
 		m.consumers = msg.consumers
@@ -314,55 +683,213 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.consumerOffset = 0
 		m.loading = false
 		m.statusMsg = fmt.Sprintf("%d consumer(s)", len(m.consumers))
+		if len(m.consumers) == 0 {
+			m.statusMsg = "No consumers — press [n] to create one"
+			m.clearManifests()
+		}
+		cmds = append(cmds, m.loadMoreConsumers(msg.total))
+
+	case consumerPageMsg:
+		if msg.gen != m.consumerPageGen {
+			break
+		}
+		if msg.err != nil {
+			m.consumersTotal = len(m.consumers)
+			m.errMsg2 = "Failed to load more consumers: " + msg.err.Error()
+			break
+		}
+		added := m.appendConsumers(msg.consumers)
+		m.consumersTotal = msg.total
+		// A page with nothing new ends the loading too: a server that ignores
+		// the page number would otherwise return the first page forever
+		if added == 0 || m.consumersTotal < len(m.consumers) {
+			m.consumersTotal = len(m.consumers)
+		}
+		if m.consumersTotal > len(m.consumers) {
+			cmds = append(cmds, m.loadConsumerPage(msg.page+1))
+		}
 
 	case manifestsLoadedMsg:
-		m.manifests = msg.manifests
+		m.manifests = m.sortedManifests(msg.manifests)
+		m.consumerHealth[msg.consumer] = rollupConsumerHealth(msg.manifests)
+		if n := maestro.CountClockSkewed(msg.manifests, time.Now()); n > 0 && !m.clockSkewWarned {
+			m.clockSkewWarned = true
+			m.alertMsg = fmt.Sprintf("%d ManifestWork(s) created after the local time — check for clock skew; ages show 0s", n)
+		}
+		if m.baselines[msg.consumer] == nil {
+			m.takeBaseline(msg.consumer)
+		}
 		m.manifestCursor = 0
 		m.manifestOffset = 0
 		m.loading = false
-		if len(m.manifests) > 0 {
-			cmds = append(cmds, m.loadDetail(m.manifests[0]))
+		if m.pendingSelectID != "" || m.pendingSelectName != "" {
+			i := m.visibleManifestIndex(m.pendingSelectID)
+			if m.pendingSelectName != "" {
+				i = slices.IndexFunc(m.filteredManifests(), func(mw maestro.ResourceBundleSummary) bool {
+					return mw.Name == m.pendingSelectName
+				})
+			}
+			if i >= 0 {
+				m.manifestCursor = i
+			}
+			m.pendingSelectID, m.pendingSelectName = "", ""
+			if sel := m.selectedManifest(); sel != nil {
+				cmds = append(cmds, m.followSelection(*sel))
+			}
+		} else if len(m.manifests) > 0 {
+			cmds = append(cmds, m.followSelection(m.manifests[0]))
 		}
 
 	case detailLoadedMsg:
+		// A load started before pinning must not replace the pinned detail
+		if m.pinnedManifestID != "" && msg.detail != nil && msg.detail.ID != m.pinnedManifestID {
+			break
+		}
+		if cmd := m.checkHealthTransition(msg.detail); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 		m.loading = false
-		m.detailFormatted = renderDetail(msg.detail)
+		m.detailLoadedAt = time.Now()
+		m.now = m.detailLoadedAt
+		m.detailFailed = false
+		if m.watchFailures > 0 {
+			if m.watchFailures >= maxWatchFailures {
+				m.errMsg2 = ""
+			}
+			m.watchFailures = 0
+			m.statusMsg = "Reconnected — watch resumed"
+		}
+		if msg.detail == nil || msg.detail.ID != m.bookmarkID {
+			m.bookmarks = nil
+			m.bookmarkID = ""
+			if msg.detail != nil {
+				m.bookmarkID = msg.detail.ID
+			}
+		}
+		m.detail = msg.detail
+		m.recordViewed(msg.detail)
+		m.detailScale = detailScale(len(msg.rawJSON), msg.detail)
+		m.detailFormatted, m.detailErrorLine = renderDetail(msg.detail, m.wideConditions)
 		m.detailJSON = msg.jsonData
 		m.detailYAML = msg.yamlData
 		m.detailRawJSON = msg.rawJSON
 		m.detailRawYAML = msg.rawYAML
-		m.detailContent = m.activeDetailContent()
+		m.detailRaw = msg.raw
+		// A refresh of the ManifestWork whose Secrets were revealed keeps them
+		// revealed; any other ManifestWork is shown redacted again
+		if msg.detail == nil || msg.detail.ID != m.secretsShownID {
+			m.secretsShownID = ""
+		}
+		if m.secretsShownID != "" || m.collapseArrays {
+			m.rerenderRawViews()
+		}
+		m.setDetailContent(m.activeDetailContent())
 		if m.searchText != "" {
 			m.rebuildSearch()
 		} else {
 			m.viewport.SetContent(m.detailContent)
-			m.viewport.GotoTop()
+			m.scrollDetailToStart()
 		}
 		if m.watching {
 			cmds = append(cmds, watchTick())
 		}
 
+	case staleTickMsg:
+		m.now = time.Time(msg)
+		m.staleTicking = m.detailFailed && !m.watching
+		if m.staleTicking {
+			cmds = append(cmds, staleTick())
+		}
+
 	case watchTickMsg:
-		if m.watching && m.client != nil {
-			selected := m.selectedManifest()
-			if selected != nil {
-				cmds = append(cmds, m.loadDetail(*selected))
+		m.now = time.Time(msg)
+		if m.watching && m.connected() {
+			if target := m.detailTarget(); target != nil {
+				cmds = append(cmds, m.loadDetail(*target))
+			}
+		}
+
+	case listWatchTickMsg:
+		if msg.gen != m.listWatchGen {
+			break
+		}
+		m.now = msg.at
+		if m.watchingList && m.connected() && !m.listRefreshing {
+			if consumer := m.activeConsumer(); consumer != "" {
+				m.listRefreshing = true
+				cmds = append(cmds, m.refreshManifests(consumer))
+				break
 			}
 		}
+		if m.watchingList {
+			cmds = append(cmds, listWatchTick(m.listWatchGen))
+		}
+
+	case idleRefreshTickMsg:
+		if msg.gen != m.idleRefreshGen || !m.connected() {
+			break
+		}
+		cmds = append(cmds, idleRefreshTick(m.opts.IdleRefresh, m.idleRefreshGen))
+		// Skip a round while a foreground load is running; the next one catches up
+		if m.loading {
+			break
+		}
+		cmds = append(cmds, m.refreshConsumers())
+		// A list watch already keeps the list fresh, more often
+		if consumer := m.activeConsumer(); consumer != "" && !m.watchingList && !m.listRefreshing {
+			m.listRefreshing = true
+			cmds = append(cmds, m.refreshManifests(consumer))
+		}
+
+	case consumersRefreshedMsg:
+		if msg.err != nil {
+			m.errMsg2 = "Background refresh failed: " + msg.err.Error()
+			break
+		}
+		m.replaceConsumersKeepingSelection(msg.consumers)
+		// The refresh has every page: stop loading the rest of the previous list
+		m.consumerPageGen++
+		m.consumersTotal = len(m.consumers)
+
+	case manifestsRefreshedMsg:
+		m.listRefreshing = false
+		if m.watchingList {
+			cmds = append(cmds, listWatchTick(m.listWatchGen))
+		}
+		if msg.err != nil {
+			m.errMsg2 = msg.err.Error()
+			break
+		}
+		m.consumerHealth[msg.consumer] = rollupConsumerHealth(msg.manifests)
+		// Drop results that arrive after the user switched consumers
+		if msg.consumer == m.activeConsumer() {
+			m.replaceManifestsKeepingSelection(msg.manifests)
+		}
 
 	case consumerCreatedMsg:
 		m.loading = false
 		m.showCreateConsumer = false
 		m.createInput.SetValue("")
+		m.createLabelsInput.SetValue("")
 		m.statusMsg = fmt.Sprintf("Consumer %q created", msg.consumer.Name)
 		cmds = append(cmds, m.reloadConsumers())
 
+	case consumerUpdatedMsg:
+		m.loading = false
+		m.showCreateConsumer = false
+		m.editConsumerID = ""
+		m.createInput.SetValue("")
+		m.createLabelsInput.SetValue("")
+		m.statusMsg = fmt.Sprintf("Consumer %q labels updated", msg.consumer.Name)
+		cmds = append(cmds, m.reloadConsumers())
+
 	case consumerDeletedMsg:
 		m.loading = false
 		m.showConfirm = false
 		m.statusMsg = "Consumer deleted"
 		m.manifests = nil
-		m.detailContent = ""
+		m.pinnedManifestID, m.pinnedConsumer = "", ""
+		m.setDetailContent("")
 		m.viewport.SetContent("")
 		cmds = append(cmds, m.reloadConsumers())
 
@@ -370,7 +897,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.showConfirm = false
 		m.statusMsg = "ManifestWork deleted"
-		m.detailContent = ""
+		m.pinnedManifestID, m.pinnedConsumer = "", ""
+		m.setDetailContent("")
 		m.viewport.SetContent("")
 		if len(m.consumers) > 0 {
 			cmds = append(cmds, m.loadManifests(m.consumers[m.consumerCursor].Name))
@@ -383,6 +911,37 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else {
 			m.errMsg2 = ""
 			m.statusMsg = "Copied to clipboard!"
+			if msg.label != "" {
+				m.statusMsg = "Copied " + msg.label
+			}
+			m.warnLargeCopy(msg.size)
+		}
+
+	case bulkListedMsg:
+		// A cancelled operation has already been reported
+		if msg.op == m.bulk {
+			cmds = append(cmds, m.bulkListed(msg))
+		}
+
+	case bulkItemMsg:
+		if msg.op == m.bulk {
+			cmds = append(cmds, m.bulkItemDone(msg))
+		}
+
+	case consumerCopiedMsg:
+		// A cancelled copy has already been reported, and a newer one may be running
+		if errors.Is(msg.err, context.Canceled) {
+			break
+		}
+		m.consumerCopyCancel = nil
+		m.loading = false
+		if msg.err != nil {
+			m.statusMsg = ""
+			m.errMsg2 = msg.err.Error()
+		} else {
+			m.errMsg2 = ""
+			m.statusMsg = "Copied " + msg.label
+			m.warnLargeCopy(msg.size)
 		}
 
 	case tea.MouseMsg:
@@ -393,10 +952,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.KeyMsg:
-		// Global quit — always wins.
+		// Global quit — always wins, except that it first cancels a running
+		// consumer copy or bulk operation.
 		if msg.Type == tea.KeyCtrlC {
+			if m.bulk != nil {
+				if !m.bulk.cancelled {
+					m.cancelBulk()
+				}
+				return m, nil
+			}
+			if m.consumerCopyCancel != nil {
+				m.consumerCopyCancel()
+				m.consumerCopyCancel = nil
+				m.loading = false
+				m.statusMsg = "Copy cancelled"
+				return m, nil
+			}
 			return m, tea.Quit
 		}
+		m.alertMsg = ""
+		// A bracketed paste only ever fills the focused input (done above); it
+		// must not run key bindings or submit a form.
+		if msg.Paste {
+			break
+		}
 
 		// Route special keys to the appropriate handler.
 		// Handlers return a new model + optional cmd; we merge the cmd into cmds.
@@ -408,10 +987,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			newM, cmd = m.handleConnectKey(msg)
 		case screenMain:
 			switch {
+			case m.bulk != nil:
+				newM, cmd = m.handleBulkKey(msg)
 			case m.showCreateConsumer:
 				newM, cmd = m.handleCreateConsumerKey(msg)
 			case m.showConfirm:
 				newM, cmd = m.handleConfirmKey(msg)
+			case m.showConsumerInfo:
+				newM, cmd = m.handleConsumerInfoKey(msg)
+			case m.showEventLog:
+				newM, cmd = m.handleEventLogKey(msg)
+			case m.showSearchPeek:
+				newM, cmd = m.handleSearchPeekKey(msg)
+			case m.showLegend:
+				newM, cmd = m.handleLegendKey(msg)
 			default:
 				newM, cmd = m.handleMainKey(msg)
 			}
@@ -425,9 +1014,65 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	m.recordStatusChanges(prevStatus, prevErr)
+
 	return m, tea.Batch(cmds...)
 }
 
+// checkHealthTransition compares the health of a freshly loaded detail with the
+// previous load of the same ManifestWork. In watch mode a flip between healthy
+// and degraded raises a highlighted notice and rings the terminal bell.
+func (m *Model) checkHealthTransition(d *maestro.ManifestWorkDetails) tea.Cmd {
+	if d == nil {
+		return nil
+	}
+	key := d.ConsumerName + "/" + d.Name
+	health := ""
+	switch workStateOf(d.DeletedAt, d.Conditions) {
+	case workHealthy:
+		health = "Healthy"
+	case workFailing:
+		health = "Degraded"
+	}
+
+	prevKey, prevHealth := m.watchedKey, m.watchedHealth
+	m.watchedKey, m.watchedHealth = key, health
+	if !m.watching || key != prevKey || prevHealth == "" || health == "" || health == prevHealth {
+		return nil
+	}
+
+	m.alertMsg = fmt.Sprintf("%s became %s", key, health)
+	m.appendEvent(statusEvent{at: time.Now(), text: m.alertMsg, isErr: health != "Healthy"})
+	return ringBell
+}
+
+// ringBell writes the terminal bell character. It goes to stderr so it does not
+// interfere with the frame Bubble Tea is drawing on stdout.
+func ringBell() tea.Msg {
+	fmt.Fprint(os.Stderr, "\a")
+	return nil
+}
+
+// recordStatusChanges appends newly raised status and error messages to the event log.
+func (m *Model) recordStatusChanges(prevStatus, prevErr string) {
+	now := time.Now()
+	if m.errMsg2 != "" && m.errMsg2 != prevErr {
+		m.appendEvent(statusEvent{at: now, text: m.errMsg2, isErr: true})
+		m.statusAt = now
+	}
+	if m.statusMsg != "" && m.statusMsg != prevStatus {
+		m.appendEvent(statusEvent{at: now, text: m.statusMsg})
+		m.statusAt = now
+	}
+}
+
+func (m *Model) appendEvent(ev statusEvent) {
+	m.events = append(m.events, ev)
+	if len(m.events) > maxEvents {
+		m.events = m.events[len(m.events)-maxEvents:]
+	}
+}
+
 // ─── Key handlers ─────────────────────────────────────────────────────────────
 
 func (m Model) handleConnectKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
@@ -442,6 +1087,20 @@ func (m Model) handleConnectKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Toggle insecure when focused on it (idx 2)
 		if m.connectFocusIdx == 2 {
 			m.connectInsecure = !m.connectInsecure
+			// TLS verification changes what is reachable, so probe again
+			if len(m.connectEndpoints) > 0 {
+				m.probeGen++
+				m.connectEndpoints = newEndpointProbes(m.opts.Endpoints)
+				return m, probeEndpointsCmd(m.connectEndpoints, m.probeGen, m.probeConfig())
+			}
+		}
+	case tea.KeyUp, tea.KeyDown:
+		if m.connectFocusIdx == 0 {
+			delta := 1
+			if msg.Type == tea.KeyUp {
+				delta = -1
+			}
+			m.pickEndpoint(delta)
 		}
 	case tea.KeyEnter:
 		if m.connectFocusIdx == 3 || m.connectFocusIdx == 1 {
@@ -454,6 +1113,25 @@ func (m Model) handleConnectKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// pickEndpoint fills the endpoint input with the configured endpoint delta
+// places away from the one it holds (wrapping), or the first one when it holds
+// none of them.
+func (m *Model) pickEndpoint(delta int) {
+	n := len(m.connectEndpoints)
+	if n == 0 {
+		return
+	}
+	next := 0
+	for i, ep := range m.connectEndpoints {
+		if ep.url == m.connectInputs[0].Value() {
+			next = ((i+delta)%n + n) % n
+			break
+		}
+	}
+	m.connectInputs[0].SetValue(m.connectEndpoints[next].url)
+	m.connectInputs[0].CursorEnd()
+}
+
 func (m *Model) syncConnectFocus() {
 	for i := range m.connectInputs {
 		m.connectInputs[i].Blur()
@@ -476,15 +1154,55 @@ func (m Model) handleCreateConsumerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.Type { //nolint:exhaustive
 	case tea.KeyEscape:
 		m.showCreateConsumer = false
+		m.editConsumerID = ""
 		m.createInput.SetValue("")
+		m.createLabelsInput.SetValue("")
+	case tea.KeyTab, tea.KeyShiftTab:
+		// A consumer's name cannot be changed, so editing stays on the labels.
+		if m.editConsumerID == "" {
+			m.createFocusIdx = 1 - m.createFocusIdx
+			m.syncCreateFocus()
+		}
 	case tea.KeyEnter:
 		name := strings.TrimSpace(m.createInput.Value())
 		if name == "" {
 			return m, nil
 		}
+		labels, err := maestro.ParseLabels(m.createLabelsInput.Value())
+		if err != nil {
+			m.errMsg2 = err.Error()
+			return m, nil
+		}
 		m.loading = true
 		m.errMsg2 = ""
-		return m, tea.Batch(spinnerTick(), m.createConsumerCmd(name))
+		if m.editConsumerID != "" {
+			return m, tea.Batch(spinnerTick(), m.updateConsumerLabelsCmd(m.editConsumerID, labels))
+		}
+		return m, tea.Batch(spinnerTick(), m.createConsumerCmd(name, labels))
+	}
+	return m, nil
+}
+
+func (m *Model) syncCreateFocus() {
+	if m.createFocusIdx == 1 {
+		m.createInput.Blur()
+		m.createLabelsInput.Focus()
+	} else {
+		m.createLabelsInput.Blur()
+		m.createInput.Focus()
+	}
+}
+
+func (m Model) handleConsumerInfoKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEscape || msg.Type == tea.KeyEnter || msg.String() == "i" {
+		m.showConsumerInfo = false
+	}
+	return m, nil
+}
+
+func (m Model) handleLegendKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEscape || msg.Type == tea.KeyEnter || msg.String() == "?" || msg.String() == "q" {
+		m.showLegend = false
 	}
 	return m, nil
 }
@@ -501,13 +1219,89 @@ func (m Model) handleConfirmKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case "consumer":
 			return m, tea.Batch(spinnerTick(), m.deleteConsumerCmd(m.confirmID))
 		case "manifest":
-			return m, tea.Batch(spinnerTick(), m.deleteManifestCmd(m.confirmID))
+			return m, tea.Batch(spinnerTick(), m.deleteManifestCmd(m.confirmID, m.confirmVer))
+		case "manifests":
+			return m, m.startBulkDelete(m.confirmConsumer, m.confirmWorks)
+		}
+	}
+	return m, nil
+}
+
+func (m Model) handleEventLogKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Type == tea.KeyEscape || msg.String() == "L" || msg.String() == "q":
+		m.showEventLog = false
+	case msg.String() == "up" || msg.String() == "k":
+		if m.eventLogOffset > 0 {
+			m.eventLogOffset--
+		}
+	case msg.String() == "down" || msg.String() == "j":
+		if m.eventLogOffset < len(m.events)-1 {
+			m.eventLogOffset++
 		}
 	}
 	return m, nil
 }
 
+func (m Model) handleSearchPeekKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	lines := m.peekLines()
+	switch {
+	case msg.Type == tea.KeyEscape || msg.Type == tea.KeyCtrlG || msg.String() == "q":
+		m.showSearchPeek = false
+	case msg.String() == "up" || msg.String() == "k":
+		if m.peekCursor > 0 {
+			m.peekCursor--
+		}
+	case msg.String() == "down" || msg.String() == "j":
+		if m.peekCursor < len(lines)-1 {
+			m.peekCursor++
+		}
+	case msg.Type == tea.KeyEnter:
+		m.showSearchPeek = false
+		if m.peekCursor < len(lines) {
+			m.jumpToMatchLine(lines[m.peekCursor])
+		}
+	}
+	m.scrollPeek()
+	return m, nil
+}
+
 func (m Model) handleMainKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// An open search bar owns the keyboard even if a mouse click moved focus to
+	// another panel: keys edit the query (forwarded to searchInput in Update)
+	// and must not also move a list cursor or scroll the viewport.
+	if m.searching {
+		return m.handleDetailKey(msg)
+	}
+
+	// Event log is available from every panel unless a text input has focus.
+	if msg.String() == "L" && !m.filtering {
+		m.showEventLog = true
+		m.eventLogOffset = 0
+		return m, nil
+	}
+	if msg.String() == "?" && !m.filtering {
+		m.showLegend = true
+		return m, nil
+	}
+	if msg.String() == "t" && !m.filtering {
+		m.cycleTheme()
+		return m, nil
+	}
+	if msg.String() == "b" && !m.filtering {
+		return m.jumpBack()
+	}
+	if msg.String() == "G" && !m.filtering {
+		command := m.connectCommand()
+		return m, copySnippetCmd(command, "connect command: "+command)
+	}
+	if (msg.Type == tea.KeyCtrlN || msg.Type == tea.KeyCtrlP) && !m.filtering {
+		if msg.Type == tea.KeyCtrlN {
+			return m.switchConsumer(1)
+		}
+		return m.switchConsumer(-1)
+	}
+
 	switch m.focused {
 	case panelConsumers:
 		return m.handleConsumersKey(msg)
@@ -537,14 +1331,41 @@ func (m Model) handleConsumersKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if len(m.consumers) > 0 {
 			m.loading = true
 			m.manifests = nil
-			m.detailContent = ""
-			m.viewport.SetContent("")
+			// A pinned detail stays for comparison with the other consumer's works
+			if m.pinnedManifestID == "" {
+				m.setDetailContent("")
+				m.viewport.SetContent("")
+			}
+			// The stacked layout only shows the list being loaded once it has focus
+			if m.stacked() {
+				m.focused = panelManifests
+			}
 			return m, tea.Batch(spinnerTick(), m.loadManifests(m.consumers[m.consumerCursor].Name))
 		}
 	case msg.String() == "n":
 		m.showCreateConsumer = true
-		m.createInput.Focus()
+		m.editConsumerID = ""
+		m.errMsg2 = ""
+		m.createFocusIdx = 0
+		m.syncCreateFocus()
 		m.createInput.SetValue("")
+		m.createLabelsInput.SetValue("")
+	case msg.String() == "e":
+		if len(m.consumers) > 0 {
+			c := m.consumers[m.consumerCursor]
+			m.showCreateConsumer = true
+			m.editConsumerID = c.ID
+			m.errMsg2 = ""
+			m.createFocusIdx = 1
+			m.syncCreateFocus()
+			m.createInput.SetValue(c.Name)
+			m.createLabelsInput.SetValue(maestro.FormatLabels(c.Labels))
+			m.createLabelsInput.CursorEnd()
+		}
+	case msg.String() == "i":
+		if len(m.consumers) > 0 {
+			m.showConsumerInfo = true
+		}
 	case msg.String() == "d":
 		if len(m.consumers) > 0 {
 			c := m.consumers[m.consumerCursor]
@@ -559,6 +1380,20 @@ func (m Model) handleConsumersKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(spinnerTick(), m.reloadConsumers())
 	case msg.String() == "y":
 		return m, m.copyToClipboardCmd()
+	case msg.String() == "Y":
+		if len(m.consumers) > 0 && m.consumerCopyCancel == nil {
+			name := m.consumers[m.consumerCursor].Name
+			ctx, cancel := context.WithCancel(context.Background())
+			m.consumerCopyCancel = cancel
+			m.loading = true
+			m.errMsg2 = ""
+			m.statusMsg = "Fetching ManifestWorks of " + name + "… [Ctrl+C] cancel"
+			return m, tea.Batch(spinnerTick(), m.copyConsumerManifestsCmd(ctx, name))
+		}
+	case msg.Type == tea.KeyCtrlY:
+		if len(m.consumers) > 0 && m.consumerCopyCancel == nil {
+			return m, m.startBulkExport(m.consumers[m.consumerCursor].Name)
+		}
 	}
 	return m, nil
 }
@@ -585,6 +1420,11 @@ func (m Model) handleManifestsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.focused = panelDetail
 	case msg.Type == tea.KeyShiftTab:
 		m.focused = panelConsumers
+	case msg.Type == tea.KeyEnter && m.stacked():
+		// The split layout shows the selection's detail alongside the list
+		if m.selectedManifest() != nil {
+			m.focused = panelDetail
+		}
 	case msg.String() == "up" || msg.String() == "k":
 		visible := m.filteredManifests()
 		if m.manifestCursor > 0 {
@@ -593,25 +1433,44 @@ func (m Model) handleManifestsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.manifestOffset = m.manifestCursor
 			}
 			if len(visible) > 0 {
-				return m, m.loadDetail(visible[m.manifestCursor])
+				return m, m.followSelection(visible[m.manifestCursor])
 			}
 		}
 	case msg.String() == "down" || msg.String() == "j":
 		visible := m.filteredManifests()
 		if m.manifestCursor < len(visible)-1 {
 			m.manifestCursor++
-			return m, m.loadDetail(visible[m.manifestCursor])
+			return m, m.followSelection(visible[m.manifestCursor])
 		}
+	case msg.String() == "]":
+		return m, m.jumpToFailing(1)
+	case msg.String() == "[":
+		return m, m.jumpToFailing(-1)
+	case msg.String() == "p":
+		return m, m.togglePin()
 	case msg.String() == "/":
 		m.filtering = true
 		m.filterInput.Focus()
 	case msg.String() == "w":
 		m.watching = !m.watching
+		m.watchFailures = 0
 		if m.watching {
 			m.statusMsg = "Watch mode ON"
 			return m, watchTick()
 		}
 		m.statusMsg = "Watch mode OFF"
+	case msg.String() == "W":
+		m.watchingList = !m.watchingList
+		if m.watchingList {
+			m.statusMsg = "List watch ON"
+			m.listWatchGen++
+			// A refresh in flight schedules the next tick when it returns
+			if m.listRefreshing {
+				return m, nil
+			}
+			return m, listWatchTick(m.listWatchGen)
+		}
+		m.statusMsg = "List watch OFF"
 	case msg.String() == "v":
 		m.cycleDetailViewMode()
 	case msg.String() == "d":
@@ -621,16 +1480,70 @@ func (m Model) handleManifestsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.showConfirm = true
 			m.confirmKind = "manifest"
 			m.confirmID = mw.ID
+			m.confirmVer = mw.Version
 			m.confirmName = mw.Name
 			m.confirmMsg = fmt.Sprintf("Delete ManifestWork %q?", mw.Name)
 		}
+	case msg.String() == "D":
+		if visible := m.filteredManifests(); len(visible) > 0 {
+			m.showConfirm = true
+			m.confirmKind = "manifests"
+			m.confirmConsumer = m.activeConsumer()
+			m.confirmWorks = slices.Clone(visible)
+			m.confirmMsg = fmt.Sprintf("Delete all %d ManifestWorks shown for consumer %q?",
+				len(visible), m.confirmConsumer)
+			if len(visible) < len(m.manifests) {
+				m.confirmMsg = fmt.Sprintf("Delete the %d ManifestWorks matching the filter on consumer %q?",
+					len(visible), m.confirmConsumer)
+			}
+		}
 	case msg.String() == "r":
 		if len(m.consumers) > 0 {
 			m.loading = true
-			return m, tea.Batch(spinnerTick(), m.loadManifests(m.consumers[m.consumerCursor].Name))
+			consumer := m.consumers[m.consumerCursor].Name
+			delete(m.baselines, consumer) // an explicit refresh starts a new baseline
+			return m, tea.Batch(spinnerTick(), m.loadManifests(consumer))
+		}
+	case msg.String() == "s":
+		keys := maestro.SortKeys()
+		m.sortBy = keys[(slices.Index(keys, m.sortBy)+1)%len(keys)]
+		m.replaceManifestsKeepingSelection(m.manifests)
+		m.statusMsg = "Sorted by " + m.sortLabel()
+	case msg.String() == "S":
+		m.sortReverse = !m.sortReverse
+		m.replaceManifestsKeepingSelection(m.manifests)
+		m.statusMsg = "Sorted by " + m.sortLabel()
+	case msg.String() == "C":
+		m.changedOnly = !m.changedOnly
+		m.manifestCursor = 0
+		m.manifestOffset = 0
+		if m.changedOnly {
+			m.statusMsg = "Showing ManifestWorks changed since " + m.baselineTime().Format("15:04:05")
+		} else {
+			m.statusMsg = "Showing all ManifestWorks"
 		}
 	case msg.String() == "y":
 		return m, m.copyToClipboardCmd()
+	case msg.String() == "Y":
+		if len(m.filteredManifests()) > 0 {
+			return m, copyTextCmd(m.manifestsReport(time.Now()))
+		}
+	case msg.String() == "g":
+		if sel := m.selectedManifest(); sel != nil {
+			command := m.reproduceCommand(*sel)
+			return m, copySnippetCmd(command, "command: "+command)
+		}
+	case msg.String() == "I":
+		if m.detail != nil {
+			return m, copySnippetCmd(statusSummary(m.detail), "status summary")
+		}
+	case msg.String() == "z":
+		m.wrapNames = !m.wrapNames
+		if m.wrapNames {
+			m.statusMsg = "Wrapping long ManifestWork names"
+		} else {
+			m.statusMsg = "Truncating long ManifestWork names"
+		}
 	}
 	return m, nil
 }
@@ -643,6 +1556,8 @@ func (m Model) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.clearSearch()
 		case tea.KeyEnter:
 			m.nextSearchMatch()
+		case tea.KeyCtrlG:
+			m.openSearchPeek()
 		}
 		return m, nil
 	}
@@ -652,15 +1567,26 @@ func (m Model) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.focused = panelConsumers
 	case msg.Type == tea.KeyShiftTab:
 		m.focused = panelManifests
+	case msg.Type == tea.KeyEscape:
+		// First Esc drops leftover search highlights, the next one clears the pane.
+		if m.searchText != "" {
+			m.clearSearch()
+		} else {
+			m.clearDetail()
+		}
 	case msg.String() == "/":
 		m.searching = true
-		m.searchInput.Focus()
+		// Focus starts the cursor blinking
+		return m, m.searchInput.Focus()
 	case msg.String() == "n":
 		m.nextSearchMatch()
 	case msg.String() == "N":
 		m.prevSearchMatch()
+	case msg.Type == tea.KeyCtrlG:
+		m.openSearchPeek()
 	case msg.String() == "w":
 		m.watching = !m.watching
+		m.watchFailures = 0
 		if m.watching {
 			m.statusMsg = "Watch mode ON"
 			return m, watchTick()
@@ -668,13 +1594,40 @@ func (m Model) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.statusMsg = "Watch mode OFF"
 	case msg.String() == "v":
 		m.cycleDetailViewMode()
+	case msg.String() == "B":
+		m.toggleRevealBinary()
+	case msg.String() == "A":
+		m.toggleCollapseArrays()
+	case msg.String() == "S":
+		m.toggleSecrets()
+	case msg.String() == "c":
+		m.toggleWideConditions()
 	case msg.String() == "y":
 		return m, m.copyToClipboardCmd()
-	case msg.String() == "r":
-		selected := m.selectedManifest()
-		if selected != nil {
-			m.loading = true
-			return m, tea.Batch(spinnerTick(), m.loadDetail(*selected))
+	case msg.String() == "alt+y":
+		return m, copySnippetCmd(m.shownContent(), "view as shown")
+	case msg.String() == "M":
+		if m.detailRaw != nil {
+			return m, m.copyManifestWorkCmd()
+		}
+	case msg.String() == "I":
+		if m.detail != nil {
+			return m, copySnippetCmd(statusSummary(m.detail), "status summary")
+		}
+	case msg.String() == "Y":
+		if m.detail != nil {
+			return m, copySnippetCmd(detailMarkdown(m.detail), "detail as Markdown")
+		}
+	case msg.Type == tea.KeyCtrlB:
+		m.toggleBookmark()
+	case msg.String() == "'":
+		m.jumpToBookmark()
+	case msg.String() == "p":
+		return m, m.togglePin()
+	case msg.String() == "r":
+		if target := m.detailTarget(); target != nil {
+			m.loading = true
+			return m, tea.Batch(spinnerTick(), m.loadDetail(*target))
 		}
 	default:
 		updated, cmd := m.viewport.Update(msg)
@@ -692,22 +1645,147 @@ func connectCmd(cfg maestro.ClientConfig) tea.Cmd {
 		if err != nil {
 			return errMsg{err}
 		}
-		consumers, err := client.ListConsumersWithDetails(context.Background())
+		return connectClientCmd(client)()
+	}
+}
+
+// connectClientCmd lists the consumers of client to finish connecting with it.
+func connectClientCmd(client Client) tea.Cmd {
+	return func() tea.Msg {
+		consumers, total, err := client.ListConsumersPage(context.Background(), 1)
 		if err != nil {
 			return errMsg{err}
 		}
-		return connectedMsg{client: client, consumers: consumers}
+		return connectedMsg{client: client, consumers: consumers, total: total}
+	}
+}
+
+// endpointProbeTimeout bounds each connect-screen reachability probe
+const endpointProbeTimeout = 3 * time.Second
+
+// probeState is the outcome of probing a configured endpoint
+type probeState int
+
+const (
+	probePending probeState = iota
+	probeReachable
+	probeUnreachable
+)
+
+// endpointProbe is a configured endpoint and the result of its latest probe
+type endpointProbe struct {
+	url   string
+	state probeState
+	err   string
+}
+
+// status describes the probe result next to the endpoint
+func (p endpointProbe) status() string {
+	switch p.state {
+	case probeReachable:
+		return "reachable"
+	case probeUnreachable:
+		return "unreachable: " + p.err
+	default:
+		return "probing…"
+	}
+}
+
+type endpointProbedMsg struct {
+	gen int
+	url string
+	err error
+}
+
+// probeConfig is the client configuration endpoint probes connect with: the
+// one the TUI was started with, and the insecure toggle of the connect form.
+func (m Model) probeConfig() maestro.ClientConfig {
+	config := m.clientConfig
+	config.GRPCInsecure = m.connectInsecure
+	return config
+}
+
+func newEndpointProbes(endpoints []string) []endpointProbe {
+	probes := make([]endpointProbe, 0, len(endpoints))
+	for _, url := range endpoints {
+		probes = append(probes, endpointProbe{url: url})
 	}
+	return probes
+}
+
+// probeEndpointsCmd probes every endpoint concurrently; each result arrives as
+// its own endpointProbedMsg, so the list updates without blocking the UI.
+func probeEndpointsCmd(endpoints []endpointProbe, gen int, config maestro.ClientConfig) tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(endpoints))
+	for _, ep := range endpoints {
+		url := ep.url
+		cmds = append(cmds, func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), endpointProbeTimeout)
+			defer cancel()
+			return endpointProbedMsg{gen: gen, url: url, err: maestro.ProbeEndpoint(ctx, url, config)}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// connected reports whether there is a server client or fixture data to load from.
+func (m Model) connected() bool {
+	return m.client != nil
 }
 
 func (m Model) reloadConsumers() tea.Cmd {
 	client := m.client
 	return func() tea.Msg {
-		consumers, err := client.ListConsumersWithDetails(context.Background())
+		consumers, total, err := client.ListConsumersPage(context.Background(), 1)
 		if err != nil {
 			return errMsg{err}
 		}
-		return consumersLoadedMsg{consumers: consumers}
+		return consumersLoadedMsg{consumers: consumers, total: total}
+	}
+}
+
+// loadMoreConsumers starts loading the consumers past the first page when
+// the server reports more, total, than it returned. The pages are appended
+// one at a time so the panel fills in while the first consumers can already
+// be browsed.
+func (m *Model) loadMoreConsumers(total int) tea.Cmd {
+	m.consumerPageGen++
+	m.consumersTotal = max(total, len(m.consumers))
+	if m.consumersTotal == len(m.consumers) {
+		return nil
+	}
+	return m.loadConsumerPage(2)
+}
+
+func (m Model) loadConsumerPage(page int) tea.Cmd {
+	client, gen := m.client, m.consumerPageGen
+	return func() tea.Msg {
+		consumers, total, err := client.ListConsumersPage(context.Background(), page)
+		return consumerPageMsg{gen: gen, page: page, consumers: consumers, total: total, err: err}
+	}
+}
+
+// appendConsumers adds a further page of consumers to the list, skipping any
+// already shown: a consumer created meanwhile shifts the later pages. The
+// cursor stays on the selected consumer, which keeps its index. It returns
+// how many consumers were added.
+func (m *Model) appendConsumers(consumers []maestro.ConsumerInfo) int {
+	added := 0
+	for _, c := range consumers {
+		if !slices.ContainsFunc(m.consumers, func(seen maestro.ConsumerInfo) bool { return seen.ID == c.ID }) {
+			m.consumers = append(m.consumers, c)
+			added++
+		}
+	}
+	return added
+}
+
+// refreshConsumers reloads the consumer list for a background refresh.
+func (m Model) refreshConsumers() tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		consumers, err := client.ListConsumersWithDetails(context.Background())
+		return consumersRefreshedMsg{consumers: consumers, err: err}
 	}
 }
 
@@ -718,48 +1796,103 @@ func (m Model) loadManifests(consumerName string) tea.Cmd {
 		if err != nil {
 			return errMsg{err}
 		}
-		return manifestsLoadedMsg{manifests: manifests}
+		return manifestsLoadedMsg{consumer: consumerName, manifests: manifests}
+	}
+}
+
+// refreshManifests re-fetches the ManifestWork list for list-watch mode without
+// resetting the cursor or reloading the detail.
+func (m Model) refreshManifests(consumerName string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		manifests, err := client.ListManifestWorksHTTP(context.Background(), consumerName)
+		return manifestsRefreshedMsg{consumer: consumerName, manifests: manifests, err: err}
 	}
 }
 
 func (m Model) loadDetail(mw maestro.ResourceBundleSummary) tea.Cmd {
+	reveal := m.revealBinary
 	client := m.client
 	return func() tea.Msg {
-		rb, err := client.GetResourceBundleHTTP(context.Background(), mw.ID)
+		detail, raw, err := client.GetResourceBundleDetailsHTTP(context.Background(), mw.ID, mw.ConsumerName)
 		if err != nil {
-			return errMsg{err}
+			return detailErrMsg{err}
 		}
-		detail := maestro.ResourceBundleToDetails(rb, mw.ConsumerName)
+		return newDetailLoadedMsg(detail, raw, reveal)
+	}
+}
 
-		// Build raw map for JSON/YAML rendering
-		raw := maestro.ResourceBundleToRawMap(rb, mw.ConsumerName)
+// newDetailLoadedMsg renders the plain and colored JSON/YAML views of raw,
+// with the values of Secret manifests redacted.
+func newDetailLoadedMsg(detail *maestro.ManifestWorkDetails, raw map[string]interface{}, reveal bool) detailLoadedMsg {
+	jsonStr, yamlStr, rawJSON, rawYAML := renderRawViews(raw, reveal, false, false)
+
+	return detailLoadedMsg{
+		detail:   detail,
+		raw:      raw,
+		jsonData: jsonStr,
+		yamlData: yamlStr,
+		rawJSON:  rawJSON,
+		rawYAML:  rawYAML,
+	}
+}
 
-		rawJSON, rawYAML := "", ""
-		jsonStr, yamlStr := "", ""
+// renderRawViews renders raw as the colored JSON and YAML views and their
+// plain text for the clipboard. Secret values are redacted unless
+// showSecrets; binary values are masked unless reveal, and long scalar arrays
+// collapsed with collapse, in the colored views only.
+func renderRawViews(
+	raw map[string]interface{},
+	reveal, showSecrets, collapse bool,
+) (jsonStr, yamlStr, rawJSON, rawYAML string) {
+	shown, _ := redactSecrets(raw, showSecrets)
+	if jsonBytes, e := json.MarshalIndent(shown, "", "  "); e == nil {
+		rawJSON = cleanRawText(string(jsonBytes))
+	}
+	if yamlBytes, e := sigyaml.Marshal(shown); e == nil {
+		rawYAML = cleanRawText(string(yamlBytes))
+	}
+	display := shown
+	if collapse {
+		display = collapseScalarArrays(shown).(map[string]interface{})
+	}
+	jsonStr, yamlStr = colorizeRawViews(display, reveal)
+	return jsonStr, yamlStr, rawJSON, rawYAML
+}
 
-		if jsonBytes, e := json.MarshalIndent(raw, "", "  "); e == nil {
-			rawJSON = string(jsonBytes)
-			jsonStr = colorizeJSON(rawJSON)
-		}
-		if yamlBytes, e := sigyaml.Marshal(raw); e == nil {
-			rawYAML = string(yamlBytes)
-			yamlStr = colorizeYAML(rawYAML)
-		}
+// cleanRawText normalizes marshaled JSON or YAML for the clipboard: no escape
+// sequences, no trailing whitespace on any line and exactly one trailing
+// newline. This never changes the document, since the marshalers quote string
+// values holding escape characters or trailing spaces.
+func cleanRawText(s string) string {
+	lines := strings.Split(stripANSI(s), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n"
+}
 
-		return detailLoadedMsg{
-			detail:   detail,
-			jsonData: jsonStr,
-			yamlData: yamlStr,
-			rawJSON:  rawJSON,
-			rawYAML:  rawYAML,
-		}
+// colorizeRawViews renders the syntax-colored JSON and YAML views of raw.
+// Unless reveal is set, binary and oversized values are replaced by placeholders
+// so they neither flood the viewport nor confuse the colorizers and search.
+func colorizeRawViews(raw map[string]interface{}, reveal bool) (jsonStr, yamlStr string) {
+	var display interface{} = raw
+	if !reveal {
+		display = maskBinaryValues(raw)
+	}
+	if jsonBytes, e := json.MarshalIndent(display, "", "  "); e == nil {
+		jsonStr = colorizeJSON(string(jsonBytes))
+	}
+	if yamlBytes, e := sigyaml.Marshal(display); e == nil {
+		yamlStr = colorizeYAML(string(yamlBytes))
 	}
+	return jsonStr, yamlStr
 }
 
-func (m Model) createConsumerCmd(name string) tea.Cmd {
+func (m Model) createConsumerCmd(name string, labels map[string]string) tea.Cmd {
 	client := m.client
 	return func() tea.Msg {
-		info, err := client.CreateConsumer(context.Background(), name)
+		info, err := client.CreateConsumer(context.Background(), name, labels)
 		if err != nil {
 			return errMsg{err}
 		}
@@ -767,22 +1900,34 @@ func (m Model) createConsumerCmd(name string) tea.Cmd {
 	}
 }
 
-func (m Model) deleteConsumerCmd(id string) tea.Cmd {
+// updateConsumerLabelsCmd replaces the labels of the consumer with the given ID.
+func (m Model) updateConsumerLabelsCmd(id string, labels map[string]string) tea.Cmd {
 	client := m.client
 	return func() tea.Msg {
-		err := client.DeleteConsumer(context.Background(), id)
+		info, err := client.UpdateConsumerLabels(context.Background(), id, labels)
 		if err != nil {
 			return errMsg{err}
 		}
+		return consumerUpdatedMsg{consumer: *info}
+	}
+}
+
+func (m Model) deleteConsumerCmd(id string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		if err := client.DeleteConsumer(context.Background(), id); err != nil {
+			return errMsg{err}
+		}
 		return consumerDeletedMsg{}
 	}
 }
 
-func (m Model) deleteManifestCmd(id string) tea.Cmd {
+// deleteManifestCmd deletes the ManifestWork only if it still has version, so a
+// change made since the list was loaded is reported as a conflict instead.
+func (m Model) deleteManifestCmd(id string, version int32) tea.Cmd {
 	client := m.client
 	return func() tea.Msg {
-		err := client.DeleteResourceBundleByID(context.Background(), id)
-		if err != nil {
+		if err := client.DeleteResourceBundleByID(context.Background(), id, version); err != nil {
 			return errMsg{err}
 		}
 		return manifestDeletedMsg{}
@@ -815,12 +1960,372 @@ func (m Model) clipboardContent() string {
 	return stripANSI(m.detailFormatted)
 }
 
+// shownContent returns the current detail view as it is displayed, with
+// placeholders for masked values and collapsed arrays, as plain text.
+func (m Model) shownContent() string {
+	switch m.detailViewMode {
+	case viewModeJSON:
+		return cleanRawText(stripANSI(m.detailJSON))
+	case viewModeYAML:
+		return cleanRawText(stripANSI(m.detailYAML))
+	}
+	return stripANSI(m.detailFormatted)
+}
+
 func (m Model) copyToClipboardCmd() tea.Cmd {
-	content := m.clipboardContent()
+	return copyTextCmd(m.clipboardContent())
+}
+
+// copyManifestWorkCmd copies the selected ManifestWork as clean YAML that can
+// be checked into git and re-applied, without status or server-populated fields.
+// Secret values are copied only when they were revealed with S, as the
+// base64 the server holds; otherwise they are redacted.
+func (m Model) copyManifestWorkCmd() tea.Cmd {
+	data, err := m.manifestWorkYAML()
+	if err != nil {
+		return func() tea.Msg { return clipboardMsg{err: err} }
+	}
+	return copySnippetCmd(data, "re-appliable ManifestWork YAML")
+}
+
+// manifestWorkYAML renders the loaded detail as the ManifestWork M copies.
+func (m Model) manifestWorkYAML() (string, error) {
+	raw := m.detailRaw
+	if !m.secretsRevealed() {
+		raw, _ = redactSecrets(raw, false)
+	}
+	data, err := sigyaml.Marshal(maestro.NormalizeManifestWork(raw))
+	return string(data), err
+}
+
+// copyConsumerManifestsCmd copies the list summaries of every ManifestWork of
+// consumer to the clipboard as one JSON array. Cancelling ctx stops the fetch;
+// the full resource bundles are copied by a bulk export instead.
+func (m Model) copyConsumerManifestsCmd(ctx context.Context, consumer string) tea.Cmd {
+	client := m.client
 	return func() tea.Msg {
-		err := clipboard.WriteAll(content)
-		return clipboardMsg{err: err}
+		works, err := client.ListManifestWorksHTTP(ctx, consumer)
+		if err != nil {
+			return consumerCopiedMsg{err: err}
+		}
+		if err := ctx.Err(); err != nil {
+			return consumerCopiedMsg{err: err}
+		}
+
+		data, err := json.MarshalIndent(append([]maestro.ResourceBundleSummary{}, works...), "", "  ")
+		if err != nil {
+			return consumerCopiedMsg{err: err}
+		}
+		if err := writeClipboard(ctx, string(data), clipboardTimeout); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return consumerCopiedMsg{err: err}
+			}
+			return consumerCopiedMsg{err: fmt.Errorf("clipboard: %w", err)}
+		}
+		return consumerCopiedMsg{
+			label: fmt.Sprintf("%d ManifestWork summaries of %s as JSON", len(works), consumer),
+			size:  len(data),
+		}
+	}
+}
+
+// copyTextCmd writes content to the system clipboard.
+func copyTextCmd(content string) tea.Cmd {
+	return copySnippetCmd(content, "")
+}
+
+// copySnippetCmd writes part of the detail view to the clipboard; label names
+// it in the status line (e.g. "line", "value").
+func copySnippetCmd(content, label string) tea.Cmd {
+	return func() tea.Msg {
+		err := writeClipboard(context.Background(), content, clipboardTimeout)
+		return clipboardMsg{err: err, label: label, size: len(content)}
+	}
+}
+
+// clipboardTimeout bounds a clipboard write. On some systems the write blocks
+// until a clipboard manager takes the content, which would otherwise leave a
+// copy unreported.
+const clipboardTimeout = 3 * time.Second
+
+// clipboardWriteAll writes to the system clipboard; tests replace it.
+var clipboardWriteAll = clipboard.WriteAll
+
+// writeClipboard writes content to the system clipboard, giving up when ctx
+// is cancelled or timeout passes. An abandoned write cannot be interrupted and
+// finishes, or stays stuck, in the background.
+func writeClipboard(ctx context.Context, content string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	done := make(chan error, 1)
+	// Read before the goroutine starts, which may outlive this call
+	write := clipboardWriteAll
+	go func() { done <- write(content) }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("no response from the system clipboard after %s", timeout)
+		}
+		return ctx.Err()
+	}
+}
+
+// warnLargeCopy raises an alert when a copy of size bytes exceeds
+// Options.ClipboardWarnSize.
+func (m *Model) warnLargeCopy(size int) {
+	if m.opts.ClipboardWarnSize > 0 && size > m.opts.ClipboardWarnSize {
+		m.alertMsg = fmt.Sprintf("Copied %s — large clipboard content may paste slowly or be truncated", formatBytes(size))
+	}
+}
+
+// reproduceCommand returns the maestro-cli invocation that shows mw outside the
+// TUI, so an interactive finding can be repeated in a script or shared.
+func (m Model) reproduceCommand(mw maestro.ResourceBundleSummary) string {
+	parts := []string{"maestro-cli", "get", "--consumer=" + shellQuote(mw.ConsumerName), "--name=" + shellQuote(mw.Name)}
+	if ep := m.clientConfig.HTTPEndpoint; ep != "" && m.opts.Fixtures == nil {
+		parts = append(parts, "--http-endpoint="+shellQuote(ep))
+	}
+	if prefix := m.clientConfig.APIPrefix; prefix != "" && prefix != maestro.DefaultAPIPrefix {
+		parts = append(parts, "--api-prefix="+shellQuote(prefix))
+	}
+	return strings.Join(parts, " ")
+}
+
+// redactedToken replaces the token in a copied connect command
+const redactedToken = "<token>"
+
+// connectCommand returns the maestro-cli tui invocation that connects the way
+// this session did, to share with a teammate. A token is not copied: its flag
+// holds a placeholder to fill in.
+func (m Model) connectCommand() string {
+	parts := []string{"maestro-cli", "tui"}
+	if m.opts.Fixtures != nil {
+		return strings.Join(append(parts, "--demo"), " ")
+	}
+	c := m.clientConfig
+	flag := func(name, value string) {
+		if value != "" {
+			parts = append(parts, "--"+name+"="+shellQuote(value))
+		}
+	}
+	flag("http-endpoint", c.HTTPEndpoint)
+	flag("grpc-endpoint", c.GRPCEndpoint)
+	if c.GRPCInsecure {
+		parts = append(parts, "--grpc-insecure")
+	}
+	flag("grpc-server-ca-file", c.GRPCServerCAFile)
+	flag("grpc-broker-ca-file", c.GRPCBrokerCAFile)
+	flag("grpc-client-cert-file", c.GRPCClientCertFile)
+	flag("grpc-client-key-file", c.GRPCClientKeyFile)
+	if c.GRPCClientToken != "" {
+		flag("grpc-client-token", redactedToken)
+	}
+	flag("grpc-client-token-file", c.GRPCClientTokenFile)
+	if c.APIPrefix != maestro.DefaultAPIPrefix {
+		flag("api-prefix", c.APIPrefix)
+	}
+	flag("tls-min-version", c.TLSMinVersion)
+	flag("as", c.ImpersonateUser)
+	for _, group := range c.ImpersonateGroups {
+		flag("as-group", group)
+	}
+	return strings.Join(parts, " ")
+}
+
+// shellQuote single-quotes s for a POSIX shell unless it only holds
+// characters that need no quoting.
+func shellQuote(s string) string {
+	safe := s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("@%+=:,./_-", r)))
+	}) < 0
+	if safe {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// manifestsReport renders a plain-text status report of the visible (filtered)
+// ManifestWorks, suitable for pasting into a chat or incident channel.
+func (m Model) manifestsReport(now time.Time) string {
+	visible := m.filteredManifests()
+
+	consumer := ""
+	if len(m.manifests) > 0 {
+		consumer = m.manifests[0].ConsumerName
+	}
+	header := fmt.Sprintf("ManifestWorks on %s (%d)", consumer, len(visible))
+	if m.filterText != "" {
+		header += fmt.Sprintf(", filter %q", m.filterText)
+	}
+
+	nameW := len("NAME")
+	for _, mw := range visible {
+		if w := lipgloss.Width(mw.Name); w > nameW {
+			nameW = w
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(header + "\n")
+	sb.WriteString(padRight("NAME", nameW) + "  " + padRight("STATUS", 11) + "  AGE\n")
+	for _, mw := range visible {
+		status := "FAIL"
+		switch workStateOf(mw.DeletedAt, mw.Conditions) {
+		case workUnknown:
+			status = "UNKNOWN"
+		case workHealthy:
+			status = "OK"
+		case workTerminating:
+			status = "TERMINATING"
+		}
+		age := "-"
+		if d, _, err := maestro.TimestampAge(mw.CreatedAt, now); err == nil {
+			age = maestro.FormatAge(d)
+		}
+		sb.WriteString(padRight(stripANSI(mw.Name), nameW) + "  " + padRight(status, 11) + "  " + age + "\n")
+	}
+	return sb.String()
+}
+
+// statusSummary describes d in one paragraph for pasting into an incident
+// ticket: name, consumer, overall health and the first failing condition,
+// looking at the ManifestWork's own conditions before its resources'.
+func statusSummary(d *maestro.ManifestWorkDetails) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "ManifestWork %q on consumer %q is %s", d.Name, d.ConsumerName, healthLabel(d))
+	var details []string
+	if d.Version > 0 {
+		details = append(details, fmt.Sprintf("version %d", d.Version))
+	}
+	if d.UpdatedAt != "" {
+		details = append(details, "updated "+d.UpdatedAt)
+	}
+	if d.DeletedAt != "" {
+		details = append(details, "deletion requested "+d.DeletedAt)
+	}
+	if len(details) > 0 {
+		sb.WriteString(" (" + strings.Join(details, ", ") + ")")
+	}
+	sb.WriteString(".")
+
+	cond, resource := firstFailingCondition(d)
+	switch {
+	case cond != nil:
+		sb.WriteString(" First failing condition: " + cond.Type + "=" + cond.Status)
+		if resource != "" {
+			sb.WriteString(" on " + resource)
+		}
+		if cond.Reason != "" {
+			sb.WriteString(", reason " + cond.Reason)
+		}
+		if cond.Message != "" {
+			sb.WriteString(": " + strings.TrimSuffix(strings.TrimSpace(cond.Message), "."))
+		}
+		sb.WriteString(".")
+	case len(d.Conditions) == 0:
+		sb.WriteString(" No conditions reported yet.")
+	default:
+		sb.WriteString(" All conditions are met.")
+	}
+	return sb.String()
+}
+
+// healthLabel names the overall state of d: Healthy, Degraded, Unknown or
+// Terminating.
+func healthLabel(d *maestro.ManifestWorkDetails) string {
+	switch workStateOf(d.DeletedAt, d.Conditions) {
+	case workUnknown:
+		return "Unknown"
+	case workHealthy:
+		return "Healthy"
+	case workTerminating:
+		return "Terminating"
 	}
+	return "Degraded"
+}
+
+// detailMarkdown renders d as a Markdown snippet for docs or a pull request:
+// a heading, a list of the ManifestWork's fields, a table of its conditions,
+// its manifests, and a table per resource that reports conditions. It is
+// built from the details rather than the styled view, so it carries no ANSI.
+func detailMarkdown(d *maestro.ManifestWorkDetails) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "### ManifestWork `%s` on `%s`\n\n", d.Name, d.ConsumerName)
+	fmt.Fprintf(&sb, "- **Status:** %s\n", healthLabel(d))
+	for _, field := range [][2]string{
+		{"ID", d.ID},
+		{"Version", strconv.Itoa(int(d.Version))},
+		{"Created", d.CreatedAt},
+		{"Updated", d.UpdatedAt},
+		{"Deletion requested", d.DeletedAt},
+		{"Delete option", d.DeleteOption},
+	} {
+		if field[1] != "" && field[1] != "0" {
+			fmt.Fprintf(&sb, "- **%s:** %s\n", field[0], field[1])
+		}
+	}
+
+	sb.WriteString("\n#### Conditions\n\n")
+	writeMarkdownConditions(&sb, d.Conditions)
+
+	fmt.Fprintf(&sb, "\n#### Manifests (%d)\n\n", len(d.Manifests))
+	for _, info := range d.Manifests {
+		fmt.Fprintf(&sb, "- `%s`\n", info.String())
+	}
+	if len(d.Manifests) == 0 {
+		sb.WriteString("None.\n")
+	}
+
+	for _, rs := range d.ResourceStatus {
+		if len(rs.Conditions) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n#### `%s`\n\n", rs.DisplayName())
+		writeMarkdownConditions(&sb, rs.Conditions)
+	}
+	return sb.String()
+}
+
+// writeMarkdownConditions writes conds as a Markdown table.
+func writeMarkdownConditions(sb *strings.Builder, conds []maestro.ConditionSummary) {
+	if len(conds) == 0 {
+		sb.WriteString("No conditions reported yet.\n")
+		return
+	}
+	sb.WriteString("| Type | Status | Reason | Message |\n")
+	sb.WriteString("|------|--------|--------|---------|\n")
+	for _, c := range conds {
+		fmt.Fprintf(sb, "| %s | %s | %s | %s |\n",
+			markdownCell(c.Type), markdownCell(c.Status), markdownCell(c.Reason), markdownCell(c.Message))
+	}
+}
+
+// markdownCell escapes s for a Markdown table cell, which must stay on one
+// line and cannot contain an unescaped pipe.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(strings.TrimSpace(s), "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// firstFailingCondition returns the first condition of d that is not True,
+// with the display name of the resource reporting it ("" for the ManifestWork
+// itself), or nil when every condition is met.
+func firstFailingCondition(d *maestro.ManifestWorkDetails) (*maestro.ConditionSummary, string) {
+	for i, c := range d.Conditions {
+		if c.Status != condStatusTrue {
+			return &d.Conditions[i], ""
+		}
+	}
+	for _, rs := range d.ResourceStatus {
+		for i, c := range rs.Conditions {
+			if c.Status != condStatusTrue {
+				return &rs.Conditions[i], rs.DisplayName()
+			}
+		}
+	}
+	return nil, ""
 }
 
 // ─── Mouse handler ────────────────────────────────────────────────────────────
@@ -830,61 +2335,66 @@ func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	leftW := int(float64(m.width) * 0.40)
-	totalH := m.height - 1
-	consumerH := int(float64(totalH) * 0.40)
-
 	x, y := msg.X, msg.Y
+	over := m.panelAt(x, y)
+
+	// Some terminals report a release without the button that was held.
+	if msg.Action == tea.MouseActionRelease && m.selecting {
+		return m.finishSelection()
+	}
 
 	switch msg.Button { //nolint:exhaustive
 	case tea.MouseButtonLeft:
+		if msg.Action == tea.MouseActionMotion && m.selecting {
+			return m.extendSelection(x, y), nil
+		}
+		if msg.Action == tea.MouseActionRelease && m.selecting {
+			return m.finishSelection()
+		}
 		if msg.Action != tea.MouseActionPress {
 			return m, nil
 		}
 		// Determine which panel was clicked and act accordingly.
-		if x < leftW {
-			if y < consumerH {
-				return m.mouseClickConsumer(y)
-			}
-			return m.mouseClickManifest(y, consumerH)
+		switch over {
+		case panelConsumers:
+			return m.mouseClickConsumer(y)
+		case panelManifests:
+			return m.mouseClickManifest(y, m.panelBounds(panelManifests).y)
 		}
-		// Clicked the detail panel — just focus it.
-		m.focused = panelDetail
+		return m.mouseClickDetail(msg, x, y)
 
 	case tea.MouseButtonWheelUp:
-		if x < leftW {
-			if y < consumerH {
-				if m.consumerCursor > 0 {
-					m.consumerCursor--
-				}
-			} else {
-				if m.manifestCursor > 0 {
-					m.manifestCursor--
-					if sel := m.selectedManifest(); sel != nil {
-						return m, m.loadDetail(*sel)
-					}
+		switch over {
+		case panelConsumers:
+			if m.consumerCursor > 0 {
+				m.consumerCursor--
+			}
+		case panelManifests:
+			if m.manifestCursor > 0 {
+				m.manifestCursor--
+				if sel := m.selectedManifest(); sel != nil {
+					return m, m.followSelection(*sel)
 				}
 			}
-		} else {
+		default:
 			m.viewport.ScrollUp(3)
 		}
 
 	case tea.MouseButtonWheelDown:
-		if x < leftW {
-			if y < consumerH {
-				if m.consumerCursor < len(m.consumers)-1 {
-					m.consumerCursor++
-				}
-			} else {
-				visible := m.filteredManifests()
-				if m.manifestCursor < len(visible)-1 {
-					m.manifestCursor++
-					if sel := m.selectedManifest(); sel != nil {
-						return m, m.loadDetail(*sel)
-					}
+		switch over {
+		case panelConsumers:
+			if m.consumerCursor < len(m.consumers)-1 {
+				m.consumerCursor++
+			}
+		case panelManifests:
+			visible := m.filteredManifests()
+			if m.manifestCursor < len(visible)-1 {
+				m.manifestCursor++
+				if sel := m.selectedManifest(); sel != nil {
+					return m, m.followSelection(*sel)
 				}
 			}
-		} else {
+		default:
 			m.viewport.ScrollDown(3)
 		}
 	}
@@ -909,58 +2419,570 @@ func (m Model) mouseClickConsumer(y int) (tea.Model, tea.Cmd) {
 	m.consumerCursor = idx
 	m.loading = true
 	m.manifests = nil
-	m.detailContent = ""
-	m.viewport.SetContent("")
+	if m.pinnedManifestID == "" {
+		m.setDetailContent("")
+		m.viewport.SetContent("")
+	}
 	return m, tea.Batch(spinnerTick(), m.loadManifests(m.consumers[idx].Name))
 }
 
-func (m Model) mouseClickManifest(y, consumerH int) (tea.Model, tea.Cmd) {
+func (m Model) mouseClickManifest(y, panelTop int) (tea.Model, tea.Cmd) {
 	// Content starts after: panelTop + border-top(1) + title(1) + filter(1) = +3
 	const headerRows = 3
-	panelY := y - consumerH
+	panelY := y - panelTop
 	itemY := panelY - headerRows
 	if itemY < 0 {
 		m.focused = panelManifests
 		return m, nil
 	}
 	visible := m.filteredManifests()
-	idx := itemY + m.manifestOffset
+	// Walk the rows, since wrapped names take more than one
+	bounds := m.panelBounds(panelManifests)
+	nameW := manifestNameWidth(bounds.w)
+	listH := bounds.h - 4 - len(m.selectedNameFooter(bounds.w-4, bounds.h-4))
+	idx := len(visible)
+	for i, row := m.manifestOffset, 0; i < len(visible) && row < listH; i++ {
+		lines, _ := manifestNameLines(visible[i].Name, nameW, m.wrapNames)
+		if row += len(lines); itemY < row {
+			idx = i
+			break
+		}
+	}
 	if idx >= len(visible) {
 		m.focused = panelManifests
 		return m, nil
 	}
 	m.focused = panelManifests
 	m.manifestCursor = idx
-	return m, m.loadDetail(visible[idx])
+	return m, m.followSelection(visible[idx])
+}
+
+// doubleClickWindow is how close together two clicks on the same detail line
+// must be to count as a double-click.
+const doubleClickWindow = 400 * time.Millisecond
+
+// mouseClickDetail focuses the detail panel. A Ctrl/Alt-click copies the plain
+// text of the clicked line; a double-click copies just the line's value. A
+// plain press also anchors a drag selection.
+func (m Model) mouseClickDetail(msg tea.MouseMsg, x, y int) (tea.Model, tea.Cmd) {
+	m.focused = panelDetail
+
+	pos, ok := m.detailCellAt(x, y)
+	if !ok || m.detailContent == "" {
+		return m, nil
+	}
+	line := strings.TrimRight(m.detailPlain[pos.line], " ")
+
+	if msg.Ctrl || msg.Alt {
+		m.lastClickAt = time.Time{}
+		return m, copySnippetCmd(strings.TrimSpace(line), "line")
+	}
+
+	now := time.Now()
+	if !m.lastClickAt.IsZero() && m.lastClickLine == pos.line && now.Sub(m.lastClickAt) <= doubleClickWindow {
+		m.lastClickAt = time.Time{}
+		return m, copySnippetCmd(lineValue(line), "value")
+	}
+	m.lastClickAt = now
+	m.lastClickLine = pos.line
+
+	m.selecting = true
+	m.selDragged = false
+	m.selAnchor = pos
+	m.selHead = pos
+	return m, nil
+}
+
+// ─── Drag selection ───────────────────────────────────────────────────────────
+
+// cellPos is a position in the detail content: a line index and a byte offset
+// into that line's plain (ANSI-stripped) text.
+type cellPos struct {
+	line, col int
+}
+
+func (p cellPos) before(o cellPos) bool {
+	return p.line < o.line || (p.line == o.line && p.col < o.col)
+}
+
+// detailCellAt maps screen coordinates to a content position, accounting for
+// the viewport's scroll offset. Coordinates above or below the viewport clamp
+// to its first or last visible line; ok is false outside the detail panel.
+func (m Model) detailCellAt(x, y int) (cellPos, bool) {
+	// Content starts after: border-top(1) + title(1) + status(1) + search(1) = row 4,
+	// and after the left border column.
+	const headerRows = 4
+	if m.panelAt(x, y) != panelDetail || m.detailContent == "" {
+		return cellPos{}, false
+	}
+
+	row := y - headerRows
+	if row < 0 {
+		row = 0
+	}
+	if row >= m.viewport.Height {
+		row = m.viewport.Height - 1
+	}
+	idx := row + m.viewport.YOffset
+	if idx >= len(m.detailPlain) {
+		idx = len(m.detailPlain) - 1
+	}
+	col := x - m.panelBounds(panelDetail).x - 1
+	if col < 0 {
+		col = 0
+	}
+	return cellPos{line: idx, col: plainOffsetAt(m.detailPlain[idx], col)}, true
+}
+
+// extendSelection moves the selection head to the dragged-to cell, scrolling
+// the viewport when the pointer leaves it so off-screen lines can be selected.
+func (m Model) extendSelection(x, y int) Model {
+	const headerRows = 4
+	switch {
+	case y < headerRows:
+		m.viewport.ScrollUp(1)
+	case y >= headerRows+m.viewport.Height:
+		m.viewport.ScrollDown(1)
+	}
+	pos, ok := m.detailCellAt(x, y)
+	if !ok {
+		return m
+	}
+	m.selHead = pos
+	m.selDragged = m.selDragged || pos != m.selAnchor
+	if m.selDragged {
+		m.renderSelection()
+	}
+	return m
+}
+
+// finishSelection ends a drag: the selected text is copied and the highlight
+// removed. A press without movement is left as a plain click.
+func (m Model) finishSelection() (tea.Model, tea.Cmd) {
+	m.selecting = false
+	if !m.selDragged {
+		return m, nil
+	}
+	m.selDragged = false
+	m.lastClickAt = time.Time{}
+	text := m.selectionText()
+	m.restoreDetailViewport()
+	if text == "" {
+		return m, nil
+	}
+	return m, copySnippetCmd(text, fmt.Sprintf("selection (%d chars)", utf8.RuneCountInString(text)))
+}
+
+// selectionRange returns the ordered selection bounds; end is exclusive and
+// extends past the character under the pointer.
+func (m Model) selectionRange(lines []string) (start, end cellPos) {
+	start, end = m.selAnchor, m.selHead
+	if end.before(start) {
+		start, end = end, start
+	}
+	if end.line >= len(lines) {
+		end = cellPos{line: len(lines) - 1, col: len(stripANSI(lines[len(lines)-1]))}
+	}
+	plain := stripANSI(lines[end.line])
+	if end.col < len(plain) {
+		_, size := utf8.DecodeRuneInString(plain[end.col:])
+		end.col += size
+	}
+	return start, end
+}
+
+// selectionText returns the plain text covered by the selection, with lines
+// joined by newlines. Positions past the end of a (reloaded) line are clamped.
+func (m Model) selectionText() string {
+	if m.detailContent == "" || m.selAnchor.line >= len(m.detailLines) {
+		return ""
+	}
+	start, end := m.selectionRange(m.detailLines)
+	var out []string
+	for i := start.line; i <= end.line; i++ {
+		plain := m.detailPlain[i]
+		from, to := 0, len(plain)
+		if i == start.line {
+			from = min(start.col, len(plain))
+		}
+		if i == end.line {
+			to = min(end.col, len(plain))
+		}
+		if from > to {
+			from = to
+		}
+		out = append(out, plain[from:to])
+	}
+	return strings.Join(out, "\n")
+}
+
+// renderSelection pushes the detail content into the viewport with the
+// selection range highlighted.
+func (m *Model) renderSelection() {
+	lines := m.detailLines
+	if m.selAnchor.line >= len(lines) {
+		return
+	}
+	start, end := m.selectionRange(lines)
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		if i < start.line || i > end.line {
+			result[i] = line
+			continue
+		}
+		from, to := 0, len(m.detailPlain[i])
+		if i == start.line {
+			from = start.col
+		}
+		if i == end.line {
+			to = end.col
+		}
+		result[i] = injectSelectionHighlight(line, m.lineCharMap(i), from, to)
+	}
+	m.viewport.SetContent(strings.Join(result, "\n"))
+}
+
+// restoreDetailViewport re-renders the detail content without a selection,
+// keeping any search highlights and the scroll position.
+func (m *Model) restoreDetailViewport() {
+	if m.searchText != "" && len(m.searchMatches) > 0 {
+		m.applySearchHighlights()
+		return
+	}
+	m.viewport.SetContent(m.detailContent)
+}
+
+// plainOffsetAt returns the byte offset in plain of the character drawn at
+// screen column cell, or len(plain) when the line is shorter.
+func plainOffsetAt(plain string, cell int) int {
+	w := 0
+	for i, r := range plain {
+		w += lipgloss.Width(string(r))
+		if w > cell {
+			return i
+		}
+	}
+	return len(plain)
 }
 
 // ─── Tick commands ────────────────────────────────────────────────────────────
 
 var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 
+// staleTickInterval is how often the stale badge is updated while the detail
+// is neither watched nor loading.
+const staleTickInterval = time.Second
+
+func staleTick() tea.Cmd {
+	return tea.Tick(staleTickInterval, func(t time.Time) tea.Msg {
+		return staleTickMsg(t)
+	})
+}
+
 func spinnerTick() tea.Cmd {
 	return tea.Tick(100*time.Millisecond, func(t time.Time) tea.Msg {
 		return spinnerTickMsg(t)
 	})
 }
 
+const (
+	// watchInterval is how often watch mode re-fetches the selected ManifestWork.
+	watchInterval = 5 * time.Second
+	// staleGrace allows for the round trip of an in-flight refresh before the
+	// displayed detail is reported as stale.
+	staleGrace = 2 * time.Second
+)
+
 func watchTick() tea.Cmd {
-	return tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
+	return watchTickAfter(watchInterval)
+}
+
+func watchTickAfter(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(t time.Time) tea.Msg {
 		return watchTickMsg(t)
 	})
 }
 
+const (
+	// watchRetryBase is the delay before the first retry of a failed watch
+	// refresh; each further failure doubles it, up to watchRetryMax.
+	watchRetryBase = 2 * time.Second
+	watchRetryMax  = time.Minute
+	// maxWatchFailures is how many consecutive failures are retried quietly
+	// before the error is shown.
+	maxWatchFailures = 5
+)
+
+// watchRetryDelay returns how long to wait after the given number of
+// consecutive failed watch refreshes.
+func watchRetryDelay(failures int) time.Duration {
+	delay := watchRetryBase
+	for i := 1; i < failures && delay < watchRetryMax; i++ {
+		delay *= 2
+	}
+	return min(delay, watchRetryMax)
+}
+
+// listWatchInterval is slower than watchInterval: each refresh lists every
+// ManifestWork on the consumer, so it is throttled to keep server load low.
+const listWatchInterval = 15 * time.Second
+
+func listWatchTick(gen int) tea.Cmd {
+	return tea.Tick(listWatchInterval, func(t time.Time) tea.Msg {
+		return listWatchTickMsg{gen: gen, at: t}
+	})
+}
+
+func idleRefreshTick(d time.Duration, gen int) tea.Cmd {
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return idleRefreshTickMsg{gen: gen}
+	})
+}
+
 // ─── Helpers ──────────────────────────────────────────────────────────────────
 
-// cycleDetailViewMode advances the view mode and refreshes the viewport.
-func (m *Model) cycleDetailViewMode() {
-	m.detailViewMode = m.detailViewMode.next()
-	m.detailContent = m.activeDetailContent()
+// cycleDetailViewMode advances the view mode and refreshes the viewport.
+func (m *Model) cycleDetailViewMode() {
+	m.detailViewMode = m.detailViewMode.next()
+	m.setDetailContent(m.activeDetailContent())
+	if m.searchText != "" {
+		m.rebuildSearch()
+	} else {
+		m.viewport.SetContent(m.detailContent)
+		m.viewport.GotoTop()
+	}
+}
+
+// clearDetail empties the detail pane and returns focus to the ManifestWork list.
+// Detail watch is stopped too, otherwise the next tick would reload the pane.
+func (m *Model) clearDetail() {
+	m.pinnedManifestID, m.pinnedConsumer = "", ""
+	m.setDetailContent("")
+	m.detailFormatted = ""
+	m.detailErrorLine = -1
+	m.detailJSON = ""
+	m.detailYAML = ""
+	m.detailRawJSON = ""
+	m.detailRawYAML = ""
+	m.detailRaw = nil
+	m.detail = nil
+	m.detailScale = ""
+	m.detailFailed = false
+	m.detailLoadedAt = time.Time{}
+	m.watching = false
+	m.watchFailures = 0
+	m.bookmarks, m.bookmarkID = nil, ""
+	m.secretsShownID = ""
+	m.viewport.SetContent("")
+	m.viewport.GotoTop()
+	m.focused = panelManifests
+	m.statusMsg = "Detail cleared"
+}
+
+// maxBookmarks is how many bookmarks a detail keeps; setting another drops the oldest.
+const maxBookmarks = 9
+
+// detailBookmark is a viewport offset bookmarked in one detail view mode;
+// the same line means something else in the other modes.
+type detailBookmark struct {
+	mode detailViewMode
+	line int
+}
+
+// modeBookmarks returns the bookmarked lines of the current view mode, sorted.
+func (m Model) modeBookmarks() []int {
+	var lines []int
+	for _, b := range m.bookmarks {
+		if b.mode == m.detailViewMode {
+			lines = append(lines, b.line)
+		}
+	}
+	slices.Sort(lines)
+	return lines
+}
+
+// toggleBookmark bookmarks the current viewport offset, or removes the
+// bookmark already there.
+func (m *Model) toggleBookmark() {
+	if m.detailContent == "" {
+		return
+	}
+	here := detailBookmark{mode: m.detailViewMode, line: m.viewport.YOffset}
+	if i := slices.Index(m.bookmarks, here); i >= 0 {
+		m.bookmarks = slices.Delete(m.bookmarks, i, i+1)
+		m.statusMsg = fmt.Sprintf("Bookmark at line %d removed", here.line+1)
+		return
+	}
+	if len(m.bookmarks) >= maxBookmarks {
+		m.bookmarks = m.bookmarks[1:]
+	}
+	m.bookmarks = append(m.bookmarks, here)
+	m.statusMsg = fmt.Sprintf("Bookmarked line %d — press ' to jump back", here.line+1)
+}
+
+// jumpToBookmark scrolls to the next bookmark below the current offset,
+// wrapping around to the first.
+func (m *Model) jumpToBookmark() {
+	lines := m.modeBookmarks()
+	if len(lines) == 0 {
+		m.statusMsg = "No bookmarks — press Ctrl+B to bookmark the current position"
+		return
+	}
+	n := 0
+	for i, line := range lines {
+		if line > m.viewport.YOffset {
+			n = i
+			break
+		}
+	}
+	m.viewport.SetYOffset(lines[n])
+	m.statusMsg = fmt.Sprintf("Bookmark %d/%d: line %d", n+1, len(lines), lines[n]+1)
+}
+
+// toggleRevealBinary switches between placeholders and the raw binary/oversized
+// values in the JSON and YAML views.
+func (m *Model) toggleRevealBinary() {
+	m.revealBinary = !m.revealBinary
+	if m.revealBinary {
+		m.statusMsg = "Showing raw binary values"
+	} else {
+		m.statusMsg = "Hiding binary values"
+	}
+	if m.detailRaw == nil {
+		return
+	}
+	m.rerenderRawViews()
+	m.setDetailContent(m.activeDetailContent())
+	if m.searchText != "" {
+		m.rebuildSearch()
+	} else {
+		m.viewport.SetContent(m.detailContent)
+	}
+}
+
+// rerenderRawViews renders the JSON and YAML views of the loaded detail again
+// after a display setting changed.
+func (m *Model) rerenderRawViews() {
+	m.detailJSON, m.detailYAML, m.detailRawJSON, m.detailRawYAML =
+		renderRawViews(m.detailRaw, m.revealBinary, m.secretsRevealed(), m.collapseArrays)
+}
+
+// secretsRevealed reports whether the Secret values of the loaded detail were
+// revealed with S.
+func (m Model) secretsRevealed() bool {
+	return m.secretsShownID != "" && m.detail != nil && m.detail.ID == m.secretsShownID
+}
+
+// auditLogger returns the logger that records Secret reveals: the
+// AuditLogger option, else the client's, else nil for the event log only.
+func (m Model) auditLogger() *logger.Logger {
+	if m.opts.AuditLogger != nil {
+		return m.opts.AuditLogger
+	}
+	return m.clientConfig.Logger
+}
+
+// toggleCollapseArrays switches between showing long scalar arrays in full
+// and as "[ N items ]" placeholders in the JSON and YAML views. The clipboard
+// keeps receiving them in full; Alt+Y copies the view as shown.
+func (m *Model) toggleCollapseArrays() {
+	m.collapseArrays = !m.collapseArrays
+	if m.collapseArrays {
+		m.statusMsg = fmt.Sprintf("Collapsing scalar arrays of more than %d items — [A] expands them", maxInlineArrayLen)
+	} else {
+		m.statusMsg = "Showing arrays in full"
+	}
+	if m.detailRaw == nil {
+		return
+	}
+	m.rerenderRawViews()
+	m.setDetailContent(m.activeDetailContent())
+	if m.searchText != "" {
+		m.rebuildSearch()
+	} else {
+		m.viewport.SetContent(m.detailContent)
+	}
+}
+
+// toggleSecrets reveals or redacts the values of the Secret manifests in the
+// JSON and YAML views. Revealing needs --show-secrets and is recorded in the
+// event log and, for an audit trail, the audit logger; it only lasts for the
+// ManifestWork it was asked for, so opening another one redacts again.
+func (m *Model) toggleSecrets() {
+	if m.detail == nil || m.detailRaw == nil {
+		return
+	}
+	switch _, secrets := redactSecrets(m.detailRaw, false); {
+	case m.secretsShownID != "":
+		m.secretsShownID = ""
+		m.statusMsg = "Secret values redacted"
+	case !m.opts.ShowSecrets:
+		m.statusMsg = "Secret values are redacted; start the TUI with --show-secrets to reveal them"
+		return
+	case secrets == 0:
+		m.statusMsg = "No Secret manifests in this ManifestWork"
+		return
+	default:
+		m.secretsShownID = m.detail.ID
+		m.statusMsg = fmt.Sprintf("Revealed decoded values of %d Secret(s) in %s/%s",
+			secrets, m.detail.ConsumerName, m.detail.Name)
+		if audit := m.auditLogger(); audit != nil {
+			audit.Warn(context.Background(), "Secret values revealed in the TUI", logger.Fields{
+				"consumer":     m.detail.ConsumerName,
+				"manifestwork": m.detail.Name,
+				"id":           m.detail.ID,
+				"secrets":      secrets,
+			})
+		}
+	}
+	m.rerenderRawViews()
+	m.setDetailContent(m.activeDetailContent())
+	if m.searchText != "" {
+		m.rebuildSearch()
+	} else {
+		m.viewport.SetContent(m.detailContent)
+	}
+}
+
+// cycleTheme switches to the next built-in theme and re-renders the detail
+// content, whose colors are baked in when it is rendered.
+func (m *Model) cycleTheme() {
+	m.themeIdx = (m.themeIdx + 1) % len(themes)
+	applyTheme(themes[m.themeIdx])
+	m.statusMsg = "Theme: " + themes[m.themeIdx].Name
+	if m.detail == nil {
+		return
+	}
+	m.detailFormatted, m.detailErrorLine = renderDetail(m.detail, m.wideConditions)
+	if m.detailRaw != nil {
+		m.rerenderRawViews()
+	}
+	m.setDetailContent(m.activeDetailContent())
+	if m.searchText != "" {
+		m.rebuildSearch()
+	} else {
+		m.viewport.SetContent(m.detailContent)
+	}
+}
+
+// toggleWideConditions switches the formatted view between the compact
+// condition list and one that shows every condition as inline JSON.
+func (m *Model) toggleWideConditions() {
+	m.wideConditions = !m.wideConditions
+	if m.wideConditions {
+		m.statusMsg = "Showing full conditions"
+	} else {
+		m.statusMsg = "Showing compact conditions"
+	}
+	if m.detail == nil {
+		return
+	}
+	m.detailFormatted, m.detailErrorLine = renderDetail(m.detail, m.wideConditions)
+	m.setDetailContent(m.activeDetailContent())
 	if m.searchText != "" {
 		m.rebuildSearch()
 	} else {
 		m.viewport.SetContent(m.detailContent)
-		m.viewport.GotoTop()
 	}
 }
 
@@ -981,39 +3003,57 @@ func (m Model) activeDetailContent() string {
 	return m.detailFormatted
 }
 
+// scrollDetailToStart scrolls a freshly loaded detail to where reading starts:
+// the first failing condition when ScrollToError is set and the formatted view
+// shows one, otherwise the top.
+func (m *Model) scrollDetailToStart() {
+	if m.opts.ScrollToError && m.detailViewMode == viewModeFormatted && m.detailErrorLine >= 0 {
+		m.viewport.SetYOffset(m.detailErrorLine)
+		return
+	}
+	m.viewport.GotoTop()
+}
+
 // ─── Search helpers ───────────────────────────────────────────────────────────
 
+// setDetailContent replaces the detail content and the per-line caches that
+// search and selection work from, so they never re-split or re-scan it.
+func (m *Model) setDetailContent(content string) {
+	m.detailContent = content
+	m.detailLines = strings.Split(content, "\n")
+	m.detailPlain = make([]string, len(m.detailLines))
+	for i, line := range m.detailLines {
+		m.detailPlain[i] = stripANSI(line)
+	}
+	m.detailCharMaps = make([][]int, len(m.detailLines))
+	m.searchHighlighted = nil
+}
+
+// lineCharMap returns the cached buildCharMap of detail line i.
+func (m *Model) lineCharMap(i int) []int {
+	if m.detailCharMaps[i] == nil {
+		m.detailCharMaps[i] = buildCharMap(m.detailLines[i])
+	}
+	return m.detailCharMaps[i]
+}
+
 // rebuildSearch recomputes all match positions in the current detail content
 // and re-renders the viewport with highlights applied.
 func (m *Model) rebuildSearch() {
 	if m.searchText == "" {
 		m.searchMatches = nil
 		m.searchCurrent = 0
+		m.searchHighlighted = nil
 		m.viewport.SetContent(m.detailContent)
 		return
 	}
 
-	source := m.detailContent
-	lines := strings.Split(source, "\n")
-	lower := strings.ToLower(m.searchText)
+	needle := foldRunes(m.searchText)
 
 	m.searchMatches = nil
-	for lineIdx, line := range lines {
-		plain := stripANSI(line)
-		lplain := strings.ToLower(plain)
-		pos := 0
-		for {
-			idx := strings.Index(lplain[pos:], lower)
-			if idx < 0 {
-				break
-			}
-			abs := pos + idx
-			m.searchMatches = append(m.searchMatches, searchMatch{
-				line:  lineIdx,
-				start: abs,
-				end:   abs + len(m.searchText),
-			})
-			pos = abs + len(m.searchText)
+	for lineIdx, plain := range m.detailPlain {
+		for _, r := range searchRanges(plain, needle) {
+			m.searchMatches = append(m.searchMatches, searchMatch{line: lineIdx, start: r[0], end: r[1]})
 		}
 	}
 
@@ -1021,42 +3061,117 @@ func (m *Model) rebuildSearch() {
 		m.searchCurrent = 0
 	}
 
-	m.applySearchHighlights(lines)
+	m.applySearchHighlights()
 	if len(m.searchMatches) > 0 {
 		m.scrollToMatch(m.searchCurrent)
 	}
 }
 
-// applySearchHighlights injects ANSI background highlights into the content
-// and pushes it into the viewport.  The source lines must match m.detailContent.
-func (m *Model) applySearchHighlights(lines []string) {
-	// Group matches by line number
-	type lineGroup struct {
-		ranges  [][2]int // {start, end} in plain-text coords
-		absIdxs []int    // corresponding index into m.searchMatches
+// foldRunes lowercases s rune by rune for case-insensitive search.
+func foldRunes(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+// searchRanges returns the [start, end) byte ranges of the non-overlapping
+// occurrences of needle (from foldRunes) in plain, ignoring case. Matching
+// works on runes, so a range never splits a multi-byte character, and its
+// offsets index plain itself even where lowercasing changes a character's
+// encoded length (the Kelvin sign "K" lowers to a one-byte "k").
+func searchRanges(plain string, needle []rune) [][2]int {
+	if len(needle) == 0 || plain == "" {
+		return nil
 	}
-	byLine := make(map[int]*lineGroup, len(m.searchMatches))
-	for absIdx, sm := range m.searchMatches {
-		lg := byLine[sm.line]
-		if lg == nil {
-			lg = &lineGroup{}
-			byLine[sm.line] = lg
+	runes := make([]rune, 0, len(plain))
+	offsets := make([]int, 0, len(plain)+1) // byte offset of each rune, then len(plain)
+	for i, r := range plain {
+		runes = append(runes, unicode.ToLower(r))
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(plain))
+
+	var ranges [][2]int
+	for i := 0; i+len(needle) <= len(runes); {
+		if slices.Equal(runes[i:i+len(needle)], needle) {
+			ranges = append(ranges, [2]int{offsets[i], offsets[i+len(needle)]})
+			i += len(needle)
+			continue
 		}
-		lg.ranges = append(lg.ranges, [2]int{sm.start, sm.end})
-		lg.absIdxs = append(lg.absIdxs, absIdx)
+		i++
 	}
+	return ranges
+}
 
+// applySearchHighlights injects ANSI background highlights into the content
+// and pushes it into the viewport.
+func (m *Model) applySearchHighlights() {
+	lines := m.detailLines
 	result := make([]string, len(lines))
-	for i, line := range lines {
-		if lg, ok := byLine[i]; ok {
-			result[i] = injectBgHighlights(line, lg.ranges, lg.absIdxs, m.searchCurrent)
-		} else {
-			result[i] = line
+	copy(result, lines)
+	// Matches are ordered by line, so each line's matches form one run.
+	for i := 0; i < len(m.searchMatches); {
+		line := m.searchMatches[i].line
+		j := i
+		for j < len(m.searchMatches) && m.searchMatches[j].line == line {
+			j++
 		}
+		if line < len(lines) {
+			result[line] = m.highlightMatchRun(line, i, j)
+		}
+		i = j
 	}
+	m.searchHighlighted = result
 	m.viewport.SetContent(strings.Join(result, "\n"))
 }
 
+// highlightMatchRun highlights m.searchMatches[from:to], which all lie on the
+// given detail line.
+func (m *Model) highlightMatchRun(line, from, to int) string {
+	ranges := make([][2]int, 0, to-from)
+	absIdxs := make([]int, 0, to-from)
+	for k := from; k < to; k++ {
+		ranges = append(ranges, [2]int{m.searchMatches[k].start, m.searchMatches[k].end})
+		absIdxs = append(absIdxs, k)
+	}
+	return injectBgHighlights(m.detailLines[line], m.lineCharMap(line), ranges, absIdxs, m.searchCurrent)
+}
+
+// rehighlightMatchLine re-injects the highlights of the line holding the
+// idx-th match, leaving every other line as it was.
+func (m *Model) rehighlightMatchLine(idx int) {
+	line := m.searchMatches[idx].line
+	if line >= len(m.searchHighlighted) {
+		return
+	}
+	from := sort.Search(len(m.searchMatches), func(k int) bool { return m.searchMatches[k].line >= line })
+	to := from
+	for to < len(m.searchMatches) && m.searchMatches[to].line == line {
+		to++
+	}
+	m.searchHighlighted[line] = m.highlightMatchRun(line, from, to)
+}
+
+// moveSearchMatch makes the match delta positions away current (wrapping).
+// Only the lines of the old and new current match are re-highlighted.
+func (m *Model) moveSearchMatch(delta int) {
+	if len(m.searchMatches) == 0 {
+		return
+	}
+	prev := m.searchCurrent
+	m.searchCurrent = ((m.searchCurrent+delta)%len(m.searchMatches) + len(m.searchMatches)) % len(m.searchMatches)
+	if len(m.searchHighlighted) != len(m.detailLines) {
+		m.applySearchHighlights()
+	} else {
+		m.rehighlightMatchLine(prev)
+		m.rehighlightMatchLine(m.searchCurrent)
+		m.viewport.SetContent(strings.Join(m.searchHighlighted, "\n"))
+	}
+	m.scrollToMatch(m.searchCurrent)
+}
+
 // scrollToMatch scrolls the viewport so the idx-th match is visible.
 func (m *Model) scrollToMatch(idx int) {
 	if idx >= len(m.searchMatches) {
@@ -1073,22 +3188,12 @@ func (m *Model) scrollToMatch(idx int) {
 
 // nextSearchMatch advances to the next match (wrapping).
 func (m *Model) nextSearchMatch() {
-	if len(m.searchMatches) == 0 {
-		return
-	}
-	m.searchCurrent = (m.searchCurrent + 1) % len(m.searchMatches)
-	m.applySearchHighlights(strings.Split(m.detailContent, "\n"))
-	m.scrollToMatch(m.searchCurrent)
+	m.moveSearchMatch(1)
 }
 
 // prevSearchMatch moves to the previous match (wrapping).
 func (m *Model) prevSearchMatch() {
-	if len(m.searchMatches) == 0 {
-		return
-	}
-	m.searchCurrent = (m.searchCurrent - 1 + len(m.searchMatches)) % len(m.searchMatches)
-	m.applySearchHighlights(strings.Split(m.detailContent, "\n"))
-	m.scrollToMatch(m.searchCurrent)
+	m.moveSearchMatch(-1)
 }
 
 // clearSearch closes the search bar and restores the unmodified content.
@@ -1099,16 +3204,75 @@ func (m *Model) clearSearch() {
 	m.searchInput.Blur()
 	m.searchMatches = nil
 	m.searchCurrent = 0
+	m.searchHighlighted = nil
 	m.viewport.SetContent(m.detailContent)
 }
 
+// peekContext is the number of lines shown around each match in the search peek.
+const peekContext = 1
+
+// peekLines returns the detail lines holding search matches, in order and
+// each once.
+func (m Model) peekLines() []int {
+	var lines []int
+	for _, match := range m.searchMatches {
+		if len(lines) == 0 || lines[len(lines)-1] != match.line {
+			lines = append(lines, match.line)
+		}
+	}
+	return lines
+}
+
+// openSearchPeek shows the search matches as a condensed list, starting at
+// the line of the current match.
+func (m *Model) openSearchPeek() {
+	if len(m.searchMatches) == 0 {
+		return
+	}
+	m.showSearchPeek = true
+	m.peekCursor = slices.Index(m.peekLines(), m.searchMatches[m.searchCurrent].line)
+	m.peekOffset = 0
+	m.scrollPeek()
+}
+
+// peekPageSize is the number of peek entries that fit the modal, each taking
+// its match line, the context around it and a separator.
+func (m Model) peekPageSize() int {
+	return max(1, (m.height-10)/(2*peekContext+2))
+}
+
+// scrollPeek keeps the peek cursor within the shown entries.
+func (m *Model) scrollPeek() {
+	page := m.peekPageSize()
+	if m.peekCursor < m.peekOffset {
+		m.peekOffset = m.peekCursor
+	}
+	if m.peekCursor >= m.peekOffset+page {
+		m.peekOffset = m.peekCursor - page + 1
+	}
+}
+
+// jumpToMatchLine makes the first match on line current and scrolls the full
+// view to it.
+func (m *Model) jumpToMatchLine(line int) {
+	for i, match := range m.searchMatches {
+		if match.line == line {
+			m.moveSearchMatch(i - m.searchCurrent)
+			return
+		}
+	}
+}
+
 func (m Model) filteredManifests() []maestro.ResourceBundleSummary {
-	if m.filterText == "" {
+	if m.filterText == "" && !m.changedOnly {
 		return m.manifests
 	}
 	lower := strings.ToLower(m.filterText)
 	var out []maestro.ResourceBundleSummary
 	for _, mw := range m.manifests {
+		if m.changedOnly && !m.changedSinceBaseline(mw) {
+			continue
+		}
 		if strings.Contains(strings.ToLower(mw.Name), lower) {
 			out = append(out, mw)
 		}
@@ -1116,6 +3280,294 @@ func (m Model) filteredManifests() []maestro.ResourceBundleSummary {
 	return out
 }
 
+// recordViewed pushes d onto the recently viewed list unless it is already on top.
+func (m *Model) recordViewed(d *maestro.ManifestWorkDetails) {
+	if d == nil {
+		return
+	}
+	if n := len(m.recentlyViewed); n > 0 && m.recentlyViewed[n-1].id == d.ID {
+		return
+	}
+	m.recentlyViewed = append(m.recentlyViewed, viewedWork{consumer: d.ConsumerName, id: d.ID, name: d.Name})
+	if len(m.recentlyViewed) > maxRecentlyViewed {
+		m.recentlyViewed = m.recentlyViewed[len(m.recentlyViewed)-maxRecentlyViewed:]
+	}
+}
+
+// jumpBack re-selects the previously viewed ManifestWork, switching consumer
+// when it belongs to another one.
+func (m Model) jumpBack() (tea.Model, tea.Cmd) {
+	if len(m.recentlyViewed) < 2 {
+		m.statusMsg = "No earlier ManifestWork to go back to"
+		return m, nil
+	}
+	// Going back is an explicit choice of what to show, so it ends a pin
+	m.pinnedManifestID, m.pinnedConsumer = "", ""
+	m.recentlyViewed = m.recentlyViewed[:len(m.recentlyViewed)-1]
+	target := m.recentlyViewed[len(m.recentlyViewed)-1]
+	m.statusMsg = fmt.Sprintf("Back to %s/%s", target.consumer, target.name)
+
+	if target.consumer == m.activeConsumer() && len(m.manifests) > 0 {
+		i := m.visibleManifestIndex(target.id)
+		if i < 0 {
+			m.statusMsg = fmt.Sprintf("%s/%s is no longer listed", target.consumer, target.name)
+			return m, nil
+		}
+		m.manifestCursor = i
+		if m.manifestOffset > i {
+			m.manifestOffset = i
+		}
+		return m, m.loadDetail(m.filteredManifests()[i])
+	}
+
+	idx := slices.IndexFunc(m.consumers, func(c maestro.ConsumerInfo) bool { return c.Name == target.consumer })
+	if idx < 0 {
+		m.statusMsg = fmt.Sprintf("Consumer %q is no longer listed", target.consumer)
+		return m, nil
+	}
+	m.consumerCursor = idx
+	m.loading = true
+	m.manifests = nil
+	m.setDetailContent("")
+	m.viewport.SetContent("")
+	m.pendingSelectID = target.id
+	return m, tea.Batch(spinnerTick(), m.loadManifests(target.consumer))
+}
+
+// switchConsumer opens the next (delta 1) or previous (delta -1) consumer,
+// wrapping around, and selects the ManifestWork with the same name as the
+// current selection once its list arrives, or the first one when it has none.
+func (m Model) switchConsumer(delta int) (tea.Model, tea.Cmd) {
+	if len(m.consumers) < 2 {
+		m.statusMsg = "No other consumer to switch to"
+		return m, nil
+	}
+	// Start from the consumer whose list is shown, which the cursor may have left
+	idx := slices.IndexFunc(m.consumers, func(c maestro.ConsumerInfo) bool { return c.Name == m.activeConsumer() })
+	if idx < 0 {
+		idx = m.consumerCursor
+	}
+	name := ""
+	if sel := m.selectedManifest(); sel != nil {
+		name = sel.Name
+	}
+	m.consumerCursor = (idx + delta + len(m.consumers)) % len(m.consumers)
+	m.loading = true
+	m.manifests = nil
+	// A pinned detail stays for comparison with the other consumer's works
+	if m.pinnedManifestID == "" {
+		m.setDetailContent("")
+		m.viewport.SetContent("")
+	}
+	m.pendingSelectID = ""
+	m.pendingSelectName = name
+	return m, tea.Batch(spinnerTick(), m.loadManifests(m.consumers[m.consumerCursor].Name))
+}
+
+// visibleManifestIndex returns the position of the ManifestWork with the given
+// ID in the filtered list, or -1.
+func (m Model) visibleManifestIndex(id string) int {
+	return slices.IndexFunc(m.filteredManifests(), func(mw maestro.ResourceBundleSummary) bool { return mw.ID == id })
+}
+
+// sortedManifests sorts manifests in place by the current sort key and returns them.
+func (m Model) sortedManifests(manifests []maestro.ResourceBundleSummary) []maestro.ResourceBundleSummary {
+	// sortBy only ever holds one of maestro.SortKeys, so this cannot fail.
+	_ = maestro.SortResourceBundles(manifests, m.sortBy, m.sortReverse)
+	return manifests
+}
+
+// sortLabel describes the current sort order, e.g. "name" or "age (reversed)".
+func (m Model) sortLabel() string {
+	if m.sortReverse {
+		return m.sortBy + " (reversed)"
+	}
+	return m.sortBy
+}
+
+// conditionBaseline is the conditions fingerprint of each ManifestWork of a
+// consumer (by ID) and when it was taken.
+type conditionBaseline struct {
+	conditions map[string]string
+	at         time.Time
+}
+
+// takeBaseline records the conditions of consumer's loaded ManifestWorks so
+// later list refreshes can be compared against them.
+func (m *Model) takeBaseline(consumer string) {
+	b := &conditionBaseline{conditions: make(map[string]string, len(m.manifests)), at: time.Now()}
+	for _, mw := range m.manifests {
+		b.conditions[mw.ID] = conditionsFingerprint(mw.Conditions)
+	}
+	m.baselines[consumer] = b
+}
+
+// baselineTime returns when the baseline of the shown consumer was taken.
+func (m Model) baselineTime() time.Time {
+	if b := m.baselines[m.activeConsumer()]; b != nil {
+		return b.at
+	}
+	return time.Time{}
+}
+
+// changedSinceBaseline reports whether mw's conditions differ from the
+// baseline of its consumer. ManifestWorks created after the baseline count as
+// changed.
+func (m Model) changedSinceBaseline(mw maestro.ResourceBundleSummary) bool {
+	b := m.baselines[mw.ConsumerName]
+	if b == nil {
+		return true
+	}
+	before, ok := b.conditions[mw.ID]
+	return !ok || before != conditionsFingerprint(mw.Conditions)
+}
+
+// conditionsFingerprint summarizes the type, status and reason of each
+// condition. Messages and timestamps are left out so that a condition that is
+// merely re-reported does not count as a change.
+func conditionsFingerprint(conds []maestro.ConditionSummary) string {
+	parts := make([]string, 0, len(conds))
+	for _, c := range conds {
+		parts = append(parts, c.Type+"="+c.Status+"/"+c.Reason)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// clearManifests empties the ManifestWork list and detail panel, for when no
+// consumer is left to show them for.
+func (m *Model) clearManifests() {
+	m.manifests = nil
+	m.manifestCursor = 0
+	m.manifestOffset = 0
+	m.setDetailContent("")
+	m.viewport.SetContent("")
+}
+
+// activeConsumer returns the consumer whose ManifestWorks are loaded, falling
+// back to the consumer under the cursor.
+func (m Model) activeConsumer() string {
+	if len(m.manifests) > 0 {
+		return m.manifests[0].ConsumerName
+	}
+	if m.consumerCursor < len(m.consumers) {
+		return m.consumers[m.consumerCursor].Name
+	}
+	return ""
+}
+
+// replaceManifestsKeepingSelection swaps in a refreshed ManifestWork list while
+// keeping the cursor on the same ManifestWork (by ID) when it still exists.
+func (m *Model) replaceManifestsKeepingSelection(manifests []maestro.ResourceBundleSummary) {
+	selectedID := ""
+	if sel := m.selectedManifest(); sel != nil {
+		selectedID = sel.ID
+	}
+	m.manifests = m.sortedManifests(manifests)
+
+	visible := m.filteredManifests()
+	for i, mw := range visible {
+		if mw.ID == selectedID {
+			m.manifestCursor = i
+			return
+		}
+	}
+	if m.manifestCursor >= len(visible) {
+		m.manifestCursor = len(visible) - 1
+	}
+	if m.manifestCursor < 0 {
+		m.manifestCursor = 0
+	}
+	if m.manifestOffset > m.manifestCursor {
+		m.manifestOffset = m.manifestCursor
+	}
+}
+
+// replaceConsumersKeepingSelection swaps in a refreshed consumer list while
+// keeping the cursor on the same consumer, or near its old position when that
+// consumer is gone.
+func (m *Model) replaceConsumersKeepingSelection(consumers []maestro.ConsumerInfo) {
+	selected := ""
+	if m.consumerCursor < len(m.consumers) {
+		selected = m.consumers[m.consumerCursor].Name
+	}
+	m.consumers = consumers
+	if i := slices.IndexFunc(consumers, func(c maestro.ConsumerInfo) bool { return c.Name == selected }); i >= 0 {
+		m.consumerCursor = i
+	}
+	m.consumerCursor = max(min(m.consumerCursor, len(consumers)-1), 0)
+	if m.consumerOffset > m.consumerCursor {
+		m.consumerOffset = m.consumerCursor
+	}
+}
+
+// jumpToFailing moves the list cursor to the next (step 1) or previous (step
+// -1) failing ManifestWork of the visible list, wrapping around, and follows
+// it like any other cursor move.
+func (m *Model) jumpToFailing(step int) tea.Cmd {
+	visible := m.filteredManifests()
+	var failing []int
+	for i, mw := range visible {
+		if workStateOf(mw.DeletedAt, mw.Conditions) == workFailing {
+			failing = append(failing, i)
+		}
+	}
+	if len(failing) == 0 {
+		m.statusMsg = "No failing ManifestWorks"
+		return nil
+	}
+
+	for i := 1; i <= len(visible); i++ {
+		idx := ((m.manifestCursor+step*i)%len(visible) + len(visible)) % len(visible)
+		if n := slices.Index(failing, idx); n >= 0 {
+			m.manifestCursor = idx
+			if m.manifestCursor < m.manifestOffset {
+				m.manifestOffset = m.manifestCursor
+			}
+			m.statusMsg = fmt.Sprintf("Failing %d/%d: %s", n+1, len(failing), visible[idx].Name)
+			return m.followSelection(visible[idx])
+		}
+	}
+	return nil
+}
+
+// followSelection loads the detail of mw, newly under the list cursor, unless
+// the detail panel is pinned to another ManifestWork.
+func (m Model) followSelection(mw maestro.ResourceBundleSummary) tea.Cmd {
+	if m.pinnedManifestID != "" {
+		return nil
+	}
+	return m.loadDetail(mw)
+}
+
+// detailTarget returns the ManifestWork the detail panel shows: the pinned one,
+// otherwise the one under the list cursor.
+func (m Model) detailTarget() *maestro.ResourceBundleSummary {
+	if m.pinnedManifestID != "" {
+		return &maestro.ResourceBundleSummary{ID: m.pinnedManifestID, ConsumerName: m.pinnedConsumer}
+	}
+	return m.selectedManifest()
+}
+
+// togglePin pins the detail panel to the ManifestWork it shows, or unpins it
+// and catches up with the list cursor.
+func (m *Model) togglePin() tea.Cmd {
+	if m.pinnedManifestID != "" {
+		m.pinnedManifestID, m.pinnedConsumer = "", ""
+		m.statusMsg = "Detail unpinned"
+		if sel := m.selectedManifest(); sel != nil && (m.detail == nil || m.detail.ID != sel.ID) {
+			return m.loadDetail(*sel)
+		}
+		return nil
+	}
+	if m.detail == nil {
+		return nil
+	}
+	m.pinnedManifestID, m.pinnedConsumer = m.detail.ID, m.detail.ConsumerName
+	m.statusMsg = fmt.Sprintf("Detail pinned to %s — [p] unpin", m.detail.Name)
+	return nil
+}
+
 func (m Model) selectedManifest() *maestro.ResourceBundleSummary {
 	visible := m.filteredManifests()
 	if len(visible) == 0 || m.manifestCursor >= len(visible) {
@@ -1125,14 +3577,140 @@ func (m Model) selectedManifest() *maestro.ResourceBundleSummary {
 	return &v
 }
 
+// settlingTransition is how recent a condition change keeps the detail
+// reporting "changed … ago" rather than "stable for …".
+const settlingTransition = time.Minute
+
+// transitionBadge tells how long the shown ManifestWork's status has held,
+// from the latest lastTransitionTime of its own and its resources'
+// conditions: "changed 3s ago" while it may still be settling, "stable for
+// 12m" after that. It follows the clock of the spinner and watch ticks.
+func (m Model) transitionBadge() string {
+	if m.detail == nil || m.opts.Deterministic {
+		return ""
+	}
+	sets := [][]maestro.ConditionSummary{m.detail.Conditions}
+	for _, rs := range m.detail.ResourceStatus {
+		sets = append(sets, rs.Conditions)
+	}
+	last, ok := maestro.LastTransition(sets...)
+	if !ok {
+		return ""
+	}
+	age := m.now.Sub(last)
+	if age < settlingTransition {
+		return styleStatusWarn.Render("changed " + maestro.FormatAge(age) + " ago")
+	}
+	return styleHelpDesc.Render("stable for " + maestro.FormatAge(age))
+}
+
+// detailStaleFor returns how long the displayed detail has gone without a
+// successful refresh, or 0 when it is not considered stale. Content only goes
+// stale while watching or after a failed refresh, and only once it is older
+// than the watch interval (plus a grace period for the in-flight request).
+func (m Model) detailStaleFor() time.Duration {
+	if m.detailContent == "" || m.detailLoadedAt.IsZero() {
+		return 0
+	}
+	if !m.watching && !m.detailFailed {
+		return 0
+	}
+	age := m.now.Sub(m.detailLoadedAt)
+	if age <= watchInterval+staleGrace {
+		return 0
+	}
+	return age
+}
+
 // detailPanelDims computes width and height for the right/detail panel.
 func (m Model) detailPanelDims() (int, int) {
-	leftW := int(float64(m.width) * 0.40)
-	rightW := m.width - leftW
+	rightW := m.panelBounds(panelDetail).w
 	rightH := m.height - 2 // minus help bar
 	return rightW, rightH
 }
 
+// ─── Layout ───────────────────────────────────────────────────────────────────
+
+// Main screen layouts accepted by Options.Layout.
+const (
+	layoutAuto    = "auto"    // stacked below stackedLayoutWidth columns, split otherwise
+	layoutSplit   = "split"   // consumers over ManifestWorks on the left, detail on the right
+	layoutStacked = "stacked" // one full-screen panel at a time, cycled with Tab
+)
+
+// stackedLayoutWidth is the terminal width below which the auto layout
+// switches to stacked, where the split panels would be too cramped to read.
+const stackedLayoutWidth = 100
+
+// LayoutNames returns the accepted layouts, default first.
+func LayoutNames() []string {
+	return []string{layoutAuto, layoutSplit, layoutStacked}
+}
+
+// stacked reports whether the main screen shows only the focused panel.
+func (m Model) stacked() bool {
+	switch m.opts.Layout {
+	case layoutStacked:
+		return true
+	case layoutSplit:
+		return false
+	default:
+		return m.width < stackedLayoutWidth
+	}
+}
+
+// panelRect is the screen area of a panel, including its border.
+type panelRect struct {
+	x, y, w, h int
+}
+
+// panelBounds returns where panel p is drawn. In the stacked layout every
+// panel fills the screen above the help bar, though only the focused one is
+// drawn at a time.
+func (m Model) panelBounds(p focusedPanel) panelRect {
+	totalH := m.height - 1 // minus help bar
+	if m.stacked() {
+		return panelRect{w: m.width, h: totalH}
+	}
+	leftW := int(float64(m.width) * 0.40)
+	consumerH := int(float64(totalH) * 0.40)
+	if m.consumersCollapsed() {
+		consumerH = 0
+	}
+	switch p {
+	case panelConsumers:
+		return panelRect{w: leftW, h: consumerH}
+	case panelManifests:
+		return panelRect{y: consumerH, w: leftW, h: totalH - consumerH}
+	default:
+		return panelRect{x: leftW, w: m.width - leftW, h: totalH}
+	}
+}
+
+// consumersCollapsed reports whether the split layout leaves out the consumers
+// panel: with a single consumer there is nothing to choose, so its
+// ManifestWorks get the whole column until the panel is focused with Tab.
+func (m Model) consumersCollapsed() bool {
+	return !m.opts.AlwaysShowConsumers && len(m.consumers) == 1 && m.consumersTotal <= 1 &&
+		m.focused != panelConsumers
+}
+
+// panelAt returns the panel drawn at screen position (x, y). Everything right
+// of the left column belongs to the detail panel, so a drag selection keeps
+// extending when the pointer leaves it vertically.
+func (m Model) panelAt(x, y int) focusedPanel {
+	if m.stacked() {
+		return m.focused
+	}
+	if x >= m.panelBounds(panelDetail).x {
+		return panelDetail
+	}
+	if y < m.panelBounds(panelManifests).y {
+		return panelConsumers
+	}
+	return panelManifests
+}
+
 // ─── View ─────────────────────────────────────────────────────────────────────
 
 // View implements tea.Model. It renders the current screen state.
@@ -1141,13 +3719,27 @@ func (m Model) View() string {
 		return "Loading..."
 	}
 
+	var view string
 	switch m.screen {
 	case screenConnect:
-		return m.viewConnect()
+		view = m.viewConnect()
 	case screenMain:
-		return m.viewMain()
+		view = m.viewMain()
 	}
-	return ""
+	if m.opts.Deterministic {
+		// Same text as the no-color profile, without changing lipgloss's
+		// process-wide renderer
+		view = stripANSI(view)
+	}
+	return view
+}
+
+// spinnerFrame returns the spinner character to draw while loading.
+func (m Model) spinnerFrame() string {
+	if m.opts.Deterministic {
+		return spinnerFrames[0]
+	}
+	return spinnerFrames[m.spinnerIdx]
 }
 
 // ─── Connect screen ───────────────────────────────────────────────────────────
@@ -1174,7 +3766,7 @@ func (m Model) viewConnect() string {
 
 	spinner := ""
 	if m.connectLoading {
-		spinner = " " + spinnerFrames[m.spinnerIdx]
+		spinner = " " + m.spinnerFrame()
 	}
 
 	errLine := ""
@@ -1187,19 +3779,34 @@ func (m Model) viewConnect() string {
 	epLabel := labelStyle.Render("HTTP Endpoint:")
 	tokLabel := labelStyle.Render("Token:        ")
 
-	content := strings.Join([]string{
+	lines := []string{
 		title,
 		"",
 		epLabel + " " + m.connectInputs[0].View(),
 		tokLabel + " " + m.connectInputs[1].View(),
+	}
+	if len(m.connectEndpoints) > 0 {
+		lines = append(lines, "", labelStyle.Render("Endpoints:")+" "+styleHelpDesc.Render("[↑↓] pick"))
+		for _, ep := range m.connectEndpoints {
+			cursor := "  "
+			if ep.url == m.connectInputs[0].Value() {
+				cursor = "> "
+			}
+			row := cursor + endpointProbeIcon(ep.state) + " " + ep.url + " " + styleHelpDesc.Render(ep.status())
+			lines = append(lines, lipgloss.NewStyle().MaxWidth(54).Render(row))
+		}
+	}
+
+	lines = append(lines,
 		"",
 		insecureVal,
 		"",
-		connectBtn + spinner,
+		connectBtn+spinner,
 		errLine,
 		"",
 		styleHelpDesc.Render("[Tab] next  [Enter] connect  [Ctrl+C] quit"),
-	}, "\n")
+	)
+	content := strings.Join(lines, "\n")
 
 	modal := styleModal.Width(60).Render(content)
 
@@ -1217,43 +3824,71 @@ func (m Model) viewConnect() string {
 // ─── Main screen ──────────────────────────────────────────────────────────────
 
 func (m Model) viewMain() string {
-	leftW := int(float64(m.width) * 0.40)
-	rightW := m.width - leftW
-	totalH := m.height - 1 // minus help bar
-
-	consumerH := int(float64(totalH) * 0.40)
-	manifestH := totalH - consumerH
-
-	left := lipgloss.JoinVertical(lipgloss.Left,
-		m.viewConsumers(leftW, consumerH),
-		m.viewManifests(leftW, manifestH),
-	)
-	right := m.viewDetail(rightW, totalH)
-
-	body := lipgloss.JoinHorizontal(lipgloss.Top, left, right)
+	var body string
+	if m.stacked() {
+		body = m.viewStackedPanel()
+	} else {
+		consumers := m.panelBounds(panelConsumers)
+		manifests := m.panelBounds(panelManifests)
+		detail := m.panelBounds(panelDetail)
+		left := m.viewManifests(manifests.w, manifests.h)
+		if consumers.h > 0 {
+			left = lipgloss.JoinVertical(lipgloss.Left, m.viewConsumers(consumers.w, consumers.h), left)
+		}
+		right := m.viewDetail(detail.w, detail.h)
+		body = lipgloss.JoinHorizontal(lipgloss.Top, left, right)
+	}
 	help := m.viewHelp()
 
 	view := lipgloss.JoinVertical(lipgloss.Left, body, help)
 
 	// Overlay modals
-	if m.showCreateConsumer {
+	if m.bulk != nil {
+		view = m.overlayModal(view, m.viewBulkModal())
+	} else if m.showCreateConsumer {
 		view = m.overlayModal(view, m.viewCreateConsumerModal())
 	} else if m.showConfirm {
 		view = m.overlayModal(view, m.viewConfirmModal())
+	} else if m.showConsumerInfo {
+		view = m.overlayModal(view, m.viewConsumerInfoModal())
+	} else if m.showEventLog {
+		view = m.overlayModal(view, m.viewEventLogModal())
+	} else if m.showSearchPeek {
+		view = m.overlayModal(view, m.viewSearchPeekModal())
+	} else if m.showLegend {
+		view = m.overlayModal(view, m.viewLegendModal())
 	}
 
 	return view
 }
 
-func (m Model) viewConsumers(w, h int) string {
-	isFocused := m.focused == panelConsumers
+// viewStackedPanel renders the focused panel across the whole screen. Status
+// messages normally live in the detail panel, so while a list has focus they
+// get a row of their own under it.
+func (m Model) viewStackedPanel() string {
+	r := m.panelBounds(m.focused)
+	if m.focused == panelDetail {
+		return m.viewDetail(r.w, r.h)
+	}
 
-	title := "Consumers"
-	if isFocused {
-		title = stylePanelTitleFocused.Render(title)
+	statusLine := m.viewStatusLine()
+	if statusLine != "" {
+		r.h--
+	}
+	var panel string
+	if m.focused == panelConsumers {
+		panel = m.viewConsumers(r.w, r.h)
 	} else {
-		title = stylePanelTitle.Render(title)
+		panel = m.viewManifests(r.w, r.h)
+	}
+	if statusLine == "" {
+		return panel
 	}
+	return lipgloss.JoinVertical(lipgloss.Left, panel, " "+statusLine)
+}
+
+func (m Model) viewConsumers(w, h int) string {
+	isFocused := m.focused == panelConsumers
 
 	innerW := w - 4
 	innerH := h - 3
@@ -1261,6 +3896,16 @@ func (m Model) viewConsumers(w, h int) string {
 		innerH = 1
 	}
 
+	title := "Consumers" + panelCount(len(m.consumers), visibleRows(len(m.consumers), m.consumerOffset, innerH))
+	if isFocused {
+		title = stylePanelTitleFocused.Render(title)
+	} else {
+		title = stylePanelTitle.Render(title)
+	}
+	if m.consumersTotal > len(m.consumers) {
+		title += " " + styleHelpDesc.Render(fmt.Sprintf("loading %d/%d…", len(m.consumers), m.consumersTotal))
+	}
+
 	var rows []string
 	for i, c := range m.consumers {
 		if i < m.consumerOffset || i >= m.consumerOffset+innerH {
@@ -1270,15 +3915,18 @@ func (m Model) viewConsumers(w, h int) string {
 		line := c.Name
 		if i == m.consumerCursor {
 			cursor = styleItemSelected.Render("> ")
-			line = styleItemSelected.Render(padRight(line, innerW-2))
+			line = styleItemSelected.Render(padRight(line, innerW-4))
 		} else {
 			line = styleItemNormal.Render(line)
 		}
-		rows = append(rows, cursor+line)
+		rows = append(rows, cursor+consumerHealthIcon(m.consumerHealth[c.Name])+" "+line)
 	}
 
 	if len(m.consumers) == 0 {
-		rows = append(rows, styleStatusUnk.Render("  (no consumers)"))
+		rows = append(rows,
+			styleStatusUnk.Render("  No consumers"),
+			styleHelpDesc.Render("  press ")+styleHelpKey.Render("[n]")+styleHelpDesc.Render(" to create one"),
+		)
 	}
 
 	content := strings.Join(rows, "\n")
@@ -1300,15 +3948,14 @@ func (m Model) viewManifests(w, h int) string {
 	isFocused := m.focused == panelManifests
 
 	watchBadge := ""
-	if m.watching {
+	switch {
+	case m.watching && m.watchingList:
+		watchBadge = " " + styleWatchBadge.Render("[WATCH: list+detail]")
+	case m.watchingList:
+		watchBadge = " " + styleWatchBadge.Render("[WATCH: list]")
+	case m.watching:
 		watchBadge = " " + styleWatchBadge.Render("[WATCH]")
 	}
-	var title string
-	if isFocused {
-		title = stylePanelTitleFocused.Render("ManifestWorks") + watchBadge
-	} else {
-		title = stylePanelTitle.Render("ManifestWorks") + watchBadge
-	}
 
 	innerW := w - 4
 	innerH := h - 4
@@ -1316,6 +3963,63 @@ func (m Model) viewManifests(w, h int) string {
 		innerH = 1
 	}
 
+	visible := m.filteredManifests()
+	nameW := manifestNameWidth(w)
+
+	footer := m.selectedNameFooter(innerW, innerH)
+	listH := innerH - len(footer)
+
+	var rows []string
+	shown := 0
+	for i := m.manifestOffset; i < len(visible) && len(rows) < listH; i++ {
+		mw := visible[i]
+		lines, _ := manifestNameLines(mw.Name, nameW, m.wrapNames)
+		if room := listH - len(rows); len(lines) > room {
+			lines = lines[:room]
+		}
+		icon := workStatusIcon(workStateOf(mw.DeletedAt, mw.Conditions))
+		for j, name := range lines {
+			cursor := "  "
+			line := name + " " + icon
+			if j > 0 {
+				line = name
+			}
+			if i == m.manifestCursor {
+				if j == 0 {
+					cursor = styleItemSelected.Render("> ")
+				}
+				line = styleItemSelected.Render(padRight(line, innerW-2))
+			} else {
+				line = styleItemNormal.Render(line)
+			}
+			rows = append(rows, cursor+line)
+		}
+		shown++
+	}
+	if len(visible) == 0 {
+		rows = append(rows, styleStatusUnk.Render("  (no manifests)"))
+	}
+	if len(footer) > 0 {
+		for len(rows) < listH {
+			rows = append(rows, "")
+		}
+		for _, line := range footer {
+			rows = append(rows, styleHelpDesc.Render(line))
+		}
+	}
+
+	titleText := "ManifestWorks" + panelCount(len(m.manifests), shown)
+	if m.consumersCollapsed() && !m.stacked() {
+		// The consumers panel is not there to tell whose they are
+		titleText = "ManifestWorks of " + m.consumers[0].Name + panelCount(len(m.manifests), shown)
+	}
+	var title string
+	if isFocused {
+		title = stylePanelTitleFocused.Render(titleText) + watchBadge
+	} else {
+		title = stylePanelTitle.Render(titleText) + watchBadge
+	}
+
 	// Filter row
 	var filterRow string
 	switch {
@@ -1326,29 +4030,14 @@ func (m Model) viewManifests(w, h int) string {
 	default:
 		filterRow = styleHelpDesc.Render("[/] to filter")
 	}
-
-	visible := m.filteredManifests()
-	var rows []string
-	for i, mw := range visible {
-		if i < m.manifestOffset || i >= m.manifestOffset+innerH {
-			continue
-		}
-		applied, available := workConditions(mw.Conditions)
-		icon := workStatusIcon(applied, available, len(mw.Conditions) > 0)
-		name := padRight(mw.Name, innerW-5)
-		cursor := "  "
-		line := name + " " + icon
-		if i == m.manifestCursor {
-			cursor = styleItemSelected.Render("> ")
-			line = styleItemSelected.Render(padRight(name+" "+icon, innerW-2))
-		} else {
-			line = styleItemNormal.Render(line)
-		}
-		rows = append(rows, cursor+line)
+	if m.sortBy != maestro.SortByName || m.sortReverse {
+		filterRow += " " + styleHelpDesc.Render("[s] sort: "+m.sortLabel())
 	}
-	if len(visible) == 0 {
-		rows = append(rows, styleStatusUnk.Render("  (no manifests)"))
+	if m.changedOnly {
+		filterRow += " " + styleFilterActive.Render("[C] changed since "+m.baselineTime().Format("15:04:05"))
 	}
+	// Keep the badges from wrapping onto a second row in a narrow panel.
+	filterRow = lipgloss.NewStyle().MaxWidth(innerW).Render(filterRow)
 
 	bs := styleBorderNormal
 	if isFocused {
@@ -1364,6 +4053,62 @@ func (m Model) viewManifests(w, h int) string {
 	return bs.Width(w - 2).Height(h - 2).Render(content)
 }
 
+const (
+	// maxNameRows is how many list rows a wrapped ManifestWork name may take.
+	maxNameRows = 2
+	// maxNameFooterRows caps the full selected name shown under the list.
+	maxNameFooterRows = 3
+)
+
+// selectedNameFooter returns the rows showing the full name of the selected
+// ManifestWork under a list innerW cells wide and innerH rows high, when the
+// name does not fit in the list, so its context is not lost while scrolling.
+func (m Model) selectedNameFooter(innerW, innerH int) []string {
+	sel := m.selectedManifest()
+	if sel == nil {
+		return nil
+	}
+	if _, cut := manifestNameLines(sel.Name, manifestNameWidth(innerW+4), m.wrapNames); !cut {
+		return nil
+	}
+	footer := maestro.WrapText(sel.Name, innerW)
+	if len(footer) > maxNameFooterRows {
+		footer = footer[:maxNameFooterRows]
+	}
+	if len(footer) >= innerH {
+		return nil
+	}
+	return footer
+}
+
+// manifestNameWidth is the width of the name column in a ManifestWorks panel
+// w cells wide: inside the border and padding, before the cursor and icon.
+func manifestNameWidth(w int) int {
+	return max(w-4-5, 1)
+}
+
+// manifestNameLines lays out a ManifestWork name in a list column width cells
+// wide: on one row truncated with an ellipsis, or with wrap set across up to
+// maxNameRows rows. cut reports whether part of the name is left out.
+func manifestNameLines(name string, width int, wrap bool) (lines []string, cut bool) {
+	rows := 1
+	if wrap {
+		rows = maxNameRows
+	}
+	for len(lines) < rows-1 && runewidth.StringWidth(name) > width {
+		head := runewidth.Truncate(name, width, "")
+		if head == "" {
+			break
+		}
+		lines = append(lines, padRight(head, width))
+		name = name[len(head):]
+	}
+	if runewidth.StringWidth(name) > width {
+		name, cut = runewidth.Truncate(name, width, "…"), true
+	}
+	return append(lines, padRight(name, width)), cut
+}
+
 func (m Model) viewDetail(w, h int) string {
 	isFocused := m.focused == panelDetail
 
@@ -1380,16 +4125,28 @@ func (m Model) viewDetail(w, h int) string {
 
 	spinner := ""
 	if m.loading {
-		spinner = " " + spinnerFrames[m.spinnerIdx]
+		spinner = " " + m.spinnerFrame()
 	}
-
-	statusLine := ""
-	if m.statusMsg != "" {
-		statusLine = styleStatusMsg.Render(m.statusMsg)
+	if m.detail != nil && workStateOf(m.detail.DeletedAt, m.detail.Conditions) == workTerminating {
+		title += " " + styleTerminatingBadge.Render(" terminating ")
 	}
-	if m.errMsg2 != "" {
-		statusLine = styleErrMsg.Render("Error: " + m.errMsg2)
+	if badge := m.transitionBadge(); badge != "" {
+		title += " " + badge
+	}
+	if m.detailScale != "" {
+		title += " " + styleHelpDesc.Render(m.detailScale)
 	}
+	if m.watching && m.watchFailures > 0 {
+		title += " " + styleStaleBadge.Render("reconnecting…")
+	}
+	if stale := m.detailStaleFor(); stale > 0 {
+		title += " " + styleStaleBadge.Render("stale — last updated "+maestro.FormatAge(stale)+" ago")
+	}
+	if m.pinnedManifestID != "" {
+		title += " " + stylePinnedBadge.Render("[PINNED]")
+	}
+
+	statusLine := m.viewStatusLine()
 
 	// Search bar — always one row tall so viewport height stays constant.
 	searchBar := m.viewSearchBar(w - 4)
@@ -1416,11 +4173,31 @@ func (m Model) viewDetail(w, h int) string {
 	return bs.Width(w - 2).Height(h - 2).Render(inner)
 }
 
+// viewStatusLine renders the current status, error or alert message, if any.
+func (m Model) viewStatusLine() string {
+	statusLine := ""
+	if m.statusMsg != "" {
+		statusLine = styleStatusMsg.Render(m.statusMsg)
+	}
+	if m.errMsg2 != "" {
+		statusLine = styleErrMsg.Render("Error: " + m.errMsg2)
+	}
+	if m.alertMsg != "" && m.errMsg2 == "" {
+		statusLine = styleAlertMsg.Render("⚠ " + m.alertMsg)
+	}
+	if statusLine != "" && m.opts.StatusTimestamps && !m.statusAt.IsZero() {
+		statusLine = styleHelpDesc.Render(m.statusAt.Format("15:04:05")) + " " + statusLine
+	}
+	return statusLine
+}
+
 // condStatusTrue is the condition status string for a satisfied condition.
 const condStatusTrue = "True"
 
-// viewSearchBar renders the one-row search bar inside the detail panel.
-func (m Model) viewSearchBar(_ int) string {
+// viewSearchBar renders the one-row search bar inside the detail panel. While
+// it has keyboard focus the bar is drawn on a highlighted background across
+// the panel width, so it is clear keys go to the query and not the viewport.
+func (m Model) viewSearchBar(width int) string {
 	if m.searching {
 		count := ""
 		if len(m.searchMatches) == 0 && m.searchText != "" {
@@ -1430,7 +4207,19 @@ func (m Model) viewSearchBar(_ int) string {
 				fmt.Sprintf(" %d/%d", m.searchCurrent+1, len(m.searchMatches)),
 			)
 		}
-		return styleSearchBar.Render(m.searchInput.View()) + count
+		// Every part of the input carries the background, since each segment
+		// resets the styling of the one before it.
+		in := m.searchInput
+		in.PromptStyle = styleSearchPrompt
+		in.TextStyle = styleSearchBarActive
+		in.PlaceholderStyle = styleSearchBarActive.Faint(true)
+		in.Cursor.Style = styleSearchPrompt
+		in.Cursor.TextStyle = styleSearchBarActive
+		bar := styleSearchBarActive
+		if barW := width - lipgloss.Width(count); barW > 0 {
+			bar = bar.Width(barW)
+		}
+		return bar.Render(in.View()) + count
 	}
 	if m.searchText != "" {
 		// Search closed but still highlighting — show match count + nav hint.
@@ -1438,7 +4227,7 @@ func (m Model) viewSearchBar(_ int) string {
 			fmt.Sprintf("%d/%d", m.searchCurrent+1, len(m.searchMatches)),
 		)
 		return styleSearchBar.Render("/ "+m.searchText) + " " + count +
-			"  " + styleHelpDesc.Render("[n] next  [N] prev  [/] reopen  [Esc] clear")
+			"  " + styleHelpDesc.Render("[n] next  [N] prev  [Ctrl+G] peek  [/] reopen  [Esc] clear")
 	}
 	return styleHelpDesc.Render("[/] search")
 }
@@ -1453,45 +4242,144 @@ func (m Model) viewHelp() string {
 	switch m.focused {
 	case panelConsumers:
 		addKey("[n]", "new")
+		addKey("[i]", "info")
+		addKey("[e]", "labels")
 		addKey("[d]", "del")
 		addKey("[y]", "copy")
+		addKey("[Y/Ctrl+Y]", "copy works/bundles")
 		addKey("[r]", "refresh")
 		addKey("[↑↓]", "nav")
 		addKey("[Enter]", "select")
 	case panelManifests:
 		addKey("[/]", "filter")
 		addKey("[w]", "watch")
+		addKey("[W]", "watch list")
+		addKey("[s/S]", "sort")
+		addKey("[C]", "changed")
 		addKey("[v]", "view mode")
 		addKey("[y]", "copy")
+		addKey("[Y]", "report")
+		addKey("[g]", "get cmd")
+		addKey("[I]", "copy summary")
+		addKey("[z]", "wrap names")
+		addKey("[]/[]", "next/prev failing")
+		addKey("[p]", "pin detail")
 		addKey("[d]", "del")
+		addKey("[D]", "del shown")
 		addKey("[r]", "refresh")
 		addKey("[↑↓]", "nav")
+		if m.stacked() {
+			addKey("[Enter]", "detail")
+		}
 	case panelDetail:
 		addKey("[w]", "watch")
 		addKey("[v]", "view mode")
+		addKey("[B]", "binary")
+		addKey("[A]", "arrays")
+		addKey("[S]", "secrets")
+		addKey("[c]", "conditions")
+		addKey("[Esc]", "clear")
 		addKey("[y]", "copy")
+		addKey("[M]", "copy manifest")
+		addKey("[Y]", "copy markdown")
+		addKey("[I]", "copy summary")
+		addKey("[Ctrl+B/']", "bookmark/jump")
+		addKey("[p]", "pin")
+		if m.searchText != "" {
+			addKey("[Ctrl+G]", "peek matches")
+		}
 		addKey("[r]", "refresh")
 		addKey("[↑↓/PgUp/PgDn]", "scroll")
 	}
+	addKey("[b]", "back")
+	addKey("[G]", "copy connect cmd")
+	addKey("[Ctrl+N/P]", "next/prev consumer")
+	addKey("[L]", "log")
+	addKey("[?]", "icons")
+	addKey("[t]", "theme")
 	addKey("[Ctrl+C]", "quit")
 
-	return styleHelpDesc.Render(" " + strings.Join(parts, "  "))
+	return styleHelpDesc.Render(fitHelp(parts, m.width))
+}
+
+// fitHelp joins the key hints into the help bar, dropping those that do not
+// fit in width and marking the cut with "…". A width of 0 keeps them all.
+func fitHelp(parts []string, width int) string {
+	line := ""
+	for i, part := range parts {
+		next := line + "  " + part
+		if i == 0 {
+			next = " " + part
+		}
+		// Room is left for the " …" unless this is the last hint
+		w := lipgloss.Width(next)
+		if fits := w <= width-2 || (i == len(parts)-1 && w <= width); width > 0 && !fits {
+			return line + " …"
+		}
+		line = next
+	}
+	return line
 }
 
 // ─── Modals ───────────────────────────────────────────────────────────────────
 
 func (m Model) viewCreateConsumerModal() string {
 	title := styleModalTitle.Render("Create Consumer")
+	nameField := m.createInput.View()
+	help := "[Tab] next field  [Enter] create  [Esc] cancel"
+	if m.editConsumerID != "" {
+		title = styleModalTitle.Render("Edit Consumer Labels")
+		nameField = styleDetailValue.Render(m.createInput.Value())
+		help = "[Enter] save  [Esc] cancel"
+	}
+	errLine := ""
+	if m.errMsg2 != "" {
+		errLine = styleErrMsg.Render("Error: " + m.errMsg2)
+	}
 	content := strings.Join([]string{
 		title,
 		"",
-		styleDetailKey.Render("Name: ") + m.createInput.View(),
-		"",
-		styleHelpDesc.Render("[Enter] create  [Esc] cancel"),
+		styleDetailKey.Render("Name:   ") + nameField,
+		styleDetailKey.Render("Labels: ") + m.createLabelsInput.View(),
+		errLine,
+		styleHelpDesc.Render(help),
 	}, "\n")
 	return styleModal.Width(50).Render(content)
 }
 
+func (m Model) viewConsumerInfoModal() string {
+	if m.consumerCursor >= len(m.consumers) {
+		return ""
+	}
+	c := m.consumers[m.consumerCursor]
+	kv := func(key, val string) string {
+		return styleDetailKey.Render(padRight(key, 8)) + " " + styleDetailValue.Render(val)
+	}
+
+	lines := []string{
+		styleModalTitle.Render("Consumer"),
+		"",
+		kv("Name:", c.Name),
+		kv("ID:", c.ID),
+		"",
+		styleDetailHeader.Render("Labels:"),
+	}
+	if len(c.Labels) == 0 {
+		lines = append(lines, "  "+styleStatusUnk.Render("(none)"))
+	} else {
+		keys := make([]string, 0, len(c.Labels))
+		for k := range c.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			lines = append(lines, "  "+styleDetailKey.Render(k+"=")+styleDetailValue.Render(c.Labels[k]))
+		}
+	}
+	lines = append(lines, "", styleHelpDesc.Render("[Esc] close"))
+	return styleModal.Width(50).Render(strings.Join(lines, "\n"))
+}
+
 func (m Model) viewConfirmModal() string {
 	title := styleModalTitle.Render("Confirm Delete")
 	content := strings.Join([]string{
@@ -1504,20 +4392,114 @@ func (m Model) viewConfirmModal() string {
 	return styleModal.Width(50).Render(content)
 }
 
+func (m Model) viewEventLogModal() string {
+	lines := []string{styleModalTitle.Render("Event Log"), ""}
+
+	rows := m.height - 10
+	if rows < 3 {
+		rows = 3
+	}
+	if len(m.events) == 0 {
+		lines = append(lines, styleStatusUnk.Render("(no events yet)"))
+	}
+	// Newest first
+	for i := len(m.events) - 1 - m.eventLogOffset; i >= 0 && len(lines) < rows+2; i-- {
+		ev := m.events[i]
+		text := styleStatusMsg.Render(ev.text)
+		if ev.isErr {
+			text = styleErrMsg.Render("Error: " + ev.text)
+		}
+		lines = append(lines, styleHelpDesc.Render(ev.at.Format("15:04:05"))+" "+text)
+	}
+
+	lines = append(lines, "", styleHelpDesc.Render(
+		fmt.Sprintf("%d event(s)  [↑↓] scroll  [Esc] close", len(m.events)),
+	))
+	return styleModal.Width(70).Render(strings.Join(lines, "\n"))
+}
+
+// viewSearchPeekModal lists the matching lines of the detail with the lines
+// around them, like grep -C, numbered as in the full view.
+func (m Model) viewSearchPeekModal() string {
+	width := min(100, m.width-6)
+	lines := []string{styleModalTitle.Render(fmt.Sprintf("Matches for %q", m.searchText)), ""}
+
+	matchLines := m.peekLines()
+	numW := len(fmt.Sprint(len(m.detailPlain)))
+	row := func(i int, style lipgloss.Style, cursor string) string {
+		text := runewidth.Truncate(strings.TrimRight(m.detailPlain[i], " "), width-numW-4, "…")
+		return cursor + styleHelpDesc.Render(fmt.Sprintf("%*d ", numW, i+1)) + style.Render(text)
+	}
+	last := -1 // last detail line shown
+	end := min(len(matchLines), m.peekOffset+m.peekPageSize())
+	for k := m.peekOffset; k < end; k++ {
+		line := matchLines[k]
+		from := max(0, line-peekContext, last+1)
+		if last >= 0 && from > last+1 {
+			lines = append(lines, styleHelpDesc.Render("--"))
+		}
+		to := min(len(m.detailPlain)-1, line+peekContext)
+		// Context of the next match is drawn with that match
+		if k+1 < end && to >= matchLines[k+1] {
+			to = matchLines[k+1] - 1
+		}
+		for i := from; i <= to; i++ {
+			switch {
+			case i == line && k == m.peekCursor:
+				lines = append(lines, row(i, styleItemSelected, styleItemSelected.Render("> ")))
+			case i == line:
+				lines = append(lines, row(i, styleDetailValue, "  "))
+			default:
+				lines = append(lines, row(i, styleHelpDesc, "  "))
+			}
+		}
+		last = to
+	}
+
+	lines = append(lines, "", styleHelpDesc.Render(
+		fmt.Sprintf("%d/%d line(s)  [↑↓] select  [Enter] show in full view  [Esc] close",
+			m.peekCursor+1, len(matchLines)),
+	))
+	return styleModal.Width(width + 4).Render(strings.Join(lines, "\n"))
+}
+
+func (m Model) viewLegendModal() string {
+	lines := []string{styleModalTitle.Render("Status Icons")}
+	for _, section := range iconLegend() {
+		lines = append(lines, "", styleDetailHeader.Render(section.title))
+		for _, entry := range section.entries {
+			lines = append(lines, "  "+entry[0]+"  "+styleHelpDesc.Render(entry[1]))
+		}
+	}
+	lines = append(lines, "", styleHelpDesc.Render("[Esc] close"))
+	return styleModal.Width(60).Render(strings.Join(lines, "\n"))
+}
+
 func (m Model) overlayModal(_ string, modal string) string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal,
-		lipgloss.WithWhitespaceBackground(lipgloss.Color("#1F2937")),
+		lipgloss.WithWhitespaceBackground(colorBackdrop),
 	)
 }
 
 // ─── Detail rendering ─────────────────────────────────────────────────────────
 
-func renderDetail(d *maestro.ManifestWorkDetails) string {
+// renderDetail renders the formatted view. With wide set, each condition is
+// followed by its full JSON (reason, message, lastTransitionTime,
+// observedGeneration) instead of just the message. errorLine is the index of
+// the line showing the first failing (False) condition, of the ManifestWork or
+// of one of its resources, or -1 when none fails.
+func renderDetail(d *maestro.ManifestWorkDetails, wide bool) (content string, errorLine int) {
 	if d == nil {
-		return styleStatusUnk.Render("(no detail available)")
+		return styleStatusUnk.Render("(no detail available)"), -1
 	}
 
 	var sb strings.Builder
+	errorLine = -1
+	markFailing := func(status string) {
+		if status == "False" && errorLine < 0 {
+			errorLine = strings.Count(sb.String(), "\n")
+		}
+	}
 
 	kv := func(key, val string) string {
 		return styleDetailKey.Render(padRight(key, 12)) + " " + styleDetailValue.Render(val)
@@ -1528,6 +4510,13 @@ func renderDetail(d *maestro.ManifestWorkDetails) string {
 	sb.WriteString(kv("Version:", fmt.Sprintf("%d", d.Version)) + "\n")
 	sb.WriteString(kv("Created:", d.CreatedAt) + "\n")
 	sb.WriteString(kv("Updated:", d.UpdatedAt) + "\n")
+	if d.DeletedAt != "" {
+		sb.WriteString(styleDetailKey.Render(padRight("Deleting:", 12)) + " " +
+			styleStatusErr.Render("since "+d.DeletedAt) + "\n")
+	}
+	if d.SourceID != "" {
+		sb.WriteString(kv("Source:", d.SourceID) + "\n")
+	}
 
 	sb.WriteString("\n")
 	sb.WriteString(styleDetailHeader.Render("Conditions:") + "\n")
@@ -1536,9 +4525,14 @@ func renderDetail(d *maestro.ManifestWorkDetails) string {
 	} else {
 		for _, c := range d.Conditions {
 			icon := conditionIcon(c.Status)
+			markFailing(c.Status)
 			sb.WriteString(fmt.Sprintf("  %s %s", icon, styleDetailValue.Render(c.Type)) + "\n")
+			if wide {
+				sb.WriteString(renderConditionJSON(c, "    "))
+				continue
+			}
 			if c.Message != "" {
-				sb.WriteString("    " + styleHelpDesc.Render(c.Message) + "\n")
+				sb.WriteString("    " + styleHelpDesc.Render(printableText(c.Message)) + "\n")
 			}
 		}
 	}
@@ -1561,24 +4555,107 @@ func renderDetail(d *maestro.ManifestWorkDetails) string {
 		sb.WriteString("\n")
 		sb.WriteString(styleDetailHeader.Render("Resource Status:") + "\n")
 		for _, rs := range d.ResourceStatus {
-			kind := rs.Kind
-			if kind == "" {
-				kind = "Unknown"
+			header := styleDetailKey.Render("  " + printableText(rs.DisplayName()) + ":")
+			if rs.Namespace != "" {
+				header += " " + styleHelpDesc.Render("("+printableText(rs.Namespace)+")")
+			}
+			sb.WriteString(header + "\n")
+			if len(rs.Conditions) == 0 {
+				sb.WriteString("    " + styleStatusUnk.Render("(no conditions)") + "\n")
 			}
-			header := fmt.Sprintf("  %s/%s:", kind, rs.Name)
-			sb.WriteString(styleDetailKey.Render(header) + "\n")
 			for _, c := range rs.Conditions {
+				condType := c.Type
+				if condType == "" {
+					condType = "(untyped)"
+				}
 				icon := conditionIcon(c.Status)
-				sb.WriteString(fmt.Sprintf("    %s %s\n", icon, styleDetailValue.Render(c.Type)))
+				markFailing(c.Status)
+				sb.WriteString(fmt.Sprintf("    %s %s\n", icon, styleDetailValue.Render(printableText(condType))))
 			}
 		}
 	}
 
+	return sb.String(), errorLine
+}
+
+// renderConditionJSON renders c as colorized, indented JSON with every line
+// prefixed by indent.
+func renderConditionJSON(c maestro.ConditionSummary, indent string) string {
+	c.Message = printableText(c.Message)
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return ""
+	}
+	var sb strings.Builder
+	for _, line := range strings.Split(colorizeJSON(string(data)), "\n") {
+		sb.WriteString(indent + line + "\n")
+	}
 	return sb.String()
 }
 
 // ─── Utility functions ────────────────────────────────────────────────────────
 
+// cleanPaste prepares a bracketed paste for the single-line inputs: line breaks
+// are dropped, so a token copied with a trailing newline or wrapped over
+// several lines arrives intact, and surrounding whitespace is trimmed.
+func cleanPaste(msg tea.KeyMsg) tea.KeyMsg {
+	text := strings.NewReplacer("\r\n", "", "\r", "", "\n", "").Replace(string(msg.Runes))
+	msg.Runes = []rune(strings.TrimSpace(text))
+	return msg
+}
+
+// detailScale summarizes how big a loaded ManifestWork is: the size of its raw
+// JSON and the number of resources it carries.
+func detailScale(jsonSize int, d *maestro.ManifestWorkDetails) string {
+	if d == nil {
+		return ""
+	}
+	n := len(d.Manifests)
+	noun := "resources"
+	if n == 1 {
+		noun = "resource"
+	}
+	return fmt.Sprintf("%s · %d %s", formatBytes(jsonSize), n, noun)
+}
+
+// formatBytes renders n as "512 B", "12 KB" or "3.4 MB".
+func formatBytes(n int) string {
+	switch {
+	case n < 1024:
+		return fmt.Sprintf("%d B", n)
+	case n < 1024*1024:
+		return fmt.Sprintf("%d KB", (n+512)/1024)
+	default:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1024*1024))
+	}
+}
+
+// panelCount renders the count suffix for a list panel title: " (40)" when every
+// item is on screen, otherwise " (40, showing 12)" where shown is the number of
+// rows actually drawn (after filtering and clipping to the panel height).
+func panelCount(total, shown int) string {
+	if total == 0 {
+		return ""
+	}
+	if shown >= total {
+		return fmt.Sprintf(" (%d)", total)
+	}
+	return fmt.Sprintf(" (%d, showing %d)", total, shown)
+}
+
+// visibleRows returns how many of n list items fit in a window of height rows
+// starting at offset.
+func visibleRows(n, offset, height int) int {
+	rows := n - offset
+	if rows > height {
+		rows = height
+	}
+	if rows < 0 {
+		rows = 0
+	}
+	return rows
+}
+
 func padRight(s string, n int) string {
 	vis := lipgloss.Width(s)
 	if vis >= n {
@@ -1587,14 +4664,250 @@ func padRight(s string, n int) string {
 	return s + strings.Repeat(" ", n-vis)
 }
 
-func workConditions(conds []maestro.ConditionSummary) (applied, available bool) {
-	for _, c := range conds {
-		if c.Type == "Applied" && c.Status == condStatusTrue {
-			applied = true
+// maxInlineValueLen is the longest unbroken string value shown verbatim in the
+// JSON/YAML views; longer ones (base64 blobs, certificates) get a placeholder.
+const maxInlineValueLen = 256
+
+// redactedValue stands in for each value of a Secret's data and stringData
+const redactedValue = "***"
+
+// redactSecrets returns raw with the data and stringData values of its Secret
+// manifests replaced by redactedValue, or with show, decoded from base64 (a
+// value that does not decode to text keeps its encoding). It also returns how
+// many Secret manifests there are; raw itself is not modified.
+func redactSecrets(raw map[string]interface{}, show bool) (map[string]interface{}, int) {
+	var manifests []map[string]interface{}
+	switch items := raw["manifests"].(type) {
+	case []map[string]interface{}:
+		manifests = items
+	case []interface{}:
+		for _, item := range items {
+			obj, _ := item.(map[string]interface{})
+			manifests = append(manifests, obj)
+		}
+	}
+
+	count := 0
+	shown := make([]interface{}, len(manifests))
+	for i, manifest := range manifests {
+		shown[i] = manifest
+		if kind, _ := manifest["kind"].(string); kind != "Secret" {
+			continue
+		}
+		count++
+		secret := make(map[string]interface{}, len(manifest))
+		for k, v := range manifest {
+			secret[k] = v
+		}
+		for _, field := range []string{"data", "stringData"} {
+			values, ok := manifest[field].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			out := make(map[string]interface{}, len(values))
+			for key, value := range values {
+				out[key] = redactedValue
+				if show {
+					out[key] = decodeSecretValue(value, field == "data")
+				}
+			}
+			secret[field] = out
+		}
+		shown[i] = secret
+	}
+	if count == 0 {
+		return raw, 0
+	}
+
+	out := make(map[string]interface{}, len(raw))
+	for k, v := range raw {
+		out[k] = v
+	}
+	out["manifests"] = shown
+	return out, count
+}
+
+// decodeSecretValue returns a Secret value for display: data values are
+// base64-decoded when they hold text, stringData values are already plain.
+func decodeSecretValue(value interface{}, encoded bool) interface{} {
+	s, ok := value.(string)
+	if !ok || !encoded {
+		return value
+	}
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || !utf8.Valid(decoded) {
+		return value
+	}
+	return string(decoded)
+}
+
+// maskBinaryValues returns a copy of v in which binary-looking string values are
+// replaced by "<binary, N bytes>" placeholders.
+func maskBinaryValues(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			out[k] = maskBinaryValues(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = maskBinaryValues(item)
+		}
+		return out
+	case []map[string]interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = maskBinaryValues(item)
+		}
+		return out
+	case string:
+		if looksBinary(val) {
+			return fmt.Sprintf("<binary, %d bytes>", len(val))
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// maxInlineArrayLen is the longest array of scalars shown item by item in the
+// JSON/YAML views while arrays are collapsed; longer ones get a placeholder.
+const maxInlineArrayLen = 10
+
+// collapseScalarArrays returns a copy of v in which arrays of more than
+// maxInlineArrayLen scalars, such as long lists of IPs or finalizers, are
+// replaced by a "[ N items ]" placeholder. Arrays holding objects or arrays
+// are kept, with their content collapsed in turn.
+func collapseScalarArrays(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			out[k] = collapseScalarArrays(item)
+		}
+		return out
+	case []interface{}:
+		if len(val) > maxInlineArrayLen && !slices.ContainsFunc(val, isContainer) {
+			return fmt.Sprintf("[ %d items ]", len(val))
+		}
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = collapseScalarArrays(item)
+		}
+		return out
+	case []map[string]interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = collapseScalarArrays(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// isContainer reports whether v is an object or an array.
+func isContainer(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}, []map[string]interface{}:
+		return true
+	}
+	return false
+}
+
+// looksBinary reports whether s is invalid UTF-8, contains control characters,
+// or is a single unbroken token too long to be read inline.
+func looksBinary(s string) bool {
+	if !utf8.ValidString(s) {
+		return true
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) && r != '\n' && r != '\t' && r != '\r' {
+			return true
+		}
+	}
+	return len(s) > maxInlineValueLen && !strings.ContainsAny(s, " \n\t")
+}
+
+// lineValue extracts the value from a detail line: the text after the first
+// "key:" separator with JSON quoting and trailing commas removed, or the whole
+// trimmed line when it has no key.
+func lineValue(line string) string {
+	v := strings.TrimSpace(line)
+	if i := strings.Index(v, ": "); i >= 0 {
+		v = strings.TrimSpace(v[i+2:])
+	} else if strings.HasSuffix(v, ":") {
+		return v
+	}
+	v = strings.TrimSuffix(v, ",")
+	if uq, err := strconv.Unquote(v); err == nil && strings.HasPrefix(v, `"`) {
+		return uq
+	}
+	return v
+}
+
+// printableText replaces control characters (including stray escape codes) so
+// server-supplied text cannot corrupt the terminal or the search offsets.
+func printableText(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == utf8.RuneError || (unicode.IsControl(r) && r != '\t') {
+			return '?'
+		}
+		return r
+	}, strings.ToValidUTF8(s, "?"))
+}
+
+// workState is the status category of a ManifestWork in the list and detail views
+type workState int
+
+const (
+	workUnknown     workState = iota // no conditions reported yet
+	workHealthy                      // applied and available
+	workFailing                      // conditions reported, but not both applied and available
+	workTerminating                  // deletion requested or in progress
+)
+
+// workStateOf categorizes a ManifestWork. Terminating wins over the
+// conditions, which may still read healthy while the resources are removed.
+func workStateOf(deletedAt string, conds []maestro.ConditionSummary) workState {
+	if maestro.IsTerminating(deletedAt, conds) {
+		return workTerminating
+	}
+	if len(conds) == 0 {
+		return workUnknown
+	}
+	if maestro.IsFailing(deletedAt, conds) {
+		return workFailing
+	}
+	return workHealthy
+}
+
+// consumerHealth rolls up the states of a consumer's ManifestWorks.
+type consumerHealth int
+
+const (
+	consumerHealthUnknown consumerHealth = iota // ManifestWorks not loaded yet
+	consumerHealthy                             // every ManifestWork is applied and available
+	consumerHealthMixed                         // some are pending, terminating or without conditions
+	consumerHealthFailing                       // at least one reports Applied or Available False
+)
+
+// rollupConsumerHealth computes a consumer's health from its ManifestWork
+// summaries. A consumer without ManifestWorks counts as healthy.
+func rollupConsumerHealth(works []maestro.ResourceBundleSummary) consumerHealth {
+	health := consumerHealthy
+	for _, w := range works {
+		for _, c := range w.Conditions {
+			if (c.Type == "Applied" || c.Type == "Available") && c.Status == "False" {
+				return consumerHealthFailing
+			}
 		}
-		if c.Type == "Available" && c.Status == condStatusTrue {
-			available = true
+		if workStateOf(w.DeletedAt, w.Conditions) != workHealthy {
+			health = consumerHealthMixed
 		}
 	}
-	return
+	return health
 }
@@ -0,0 +1,1521 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	sigyaml "sigs.k8s.io/yaml"
+
+	"github.com/openshift-hyperfleet/maestro-cli/internal/maestro"
+	"github.com/openshift-hyperfleet/maestro-cli/pkg/logger"
+)
+
+func TestRenderDetailSparseResourceStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		rs       maestro.ResourceStatusInfo
+		expected []string
+	}{
+		{
+			name:     "empty entry",
+			rs:       maestro.ResourceStatusInfo{},
+			expected: []string{"  Unknown/(unnamed):", "    (no conditions)"},
+		},
+		{
+			name: "custom resource without kind",
+			rs: maestro.ResourceStatusInfo{
+				Resource:   "widgets",
+				Name:       "w1",
+				Namespace:  "apps",
+				Conditions: []maestro.ConditionSummary{{Status: "False"}},
+			},
+			expected: []string{"  widgets/w1: (apps)", "    ✗ (untyped)"},
+		},
+		{
+			name: "well formed",
+			rs: maestro.ResourceStatusInfo{
+				Kind:       "Deployment",
+				Name:       "web",
+				Conditions: []maestro.ConditionSummary{{Type: "Available", Status: "True"}},
+			},
+			expected: []string{"  Deployment/web:", "    ✓ Available"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &maestro.ManifestWorkDetails{Name: "work", ResourceStatus: []maestro.ResourceStatusInfo{tt.rs}}
+			content, _ := renderDetail(d, false)
+			out := stripANSI(content)
+			_, section, ok := strings.Cut(out, "Resource Status:\n")
+			if !ok {
+				t.Fatalf("no Resource Status section in:\n%s", out)
+			}
+			got := strings.Split(strings.TrimSuffix(section, "\n"), "\n")
+			if strings.Join(got, "\n") != strings.Join(tt.expected, "\n") {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRenderDetailErrorLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		detail   *maestro.ManifestWorkDetails
+		expected string // plain text of the line errorLine points at; empty for -1
+	}{
+		{name: "no detail"},
+		{
+			name: "all conditions true",
+			detail: &maestro.ManifestWorkDetails{
+				Conditions: []maestro.ConditionSummary{{Type: "Applied", Status: "True"}},
+			},
+		},
+		{
+			name: "failing work condition",
+			detail: &maestro.ManifestWorkDetails{
+				Conditions: []maestro.ConditionSummary{
+					{Type: "Applied", Status: "True", Message: "applied"},
+					{Type: "Available", Status: "False"},
+				},
+			},
+			expected: "  ✗ Available",
+		},
+		{
+			name: "failing resource condition",
+			detail: &maestro.ManifestWorkDetails{
+				Conditions: []maestro.ConditionSummary{{Type: "Applied", Status: "True"}},
+				ResourceStatus: []maestro.ResourceStatusInfo{{
+					Kind:       "Job",
+					Name:       "migrate",
+					Conditions: []maestro.ConditionSummary{{Type: "Complete", Status: "False"}},
+				}},
+			},
+			expected: "    ✗ Complete",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, errorLine := renderDetail(tt.detail, false)
+			if tt.expected == "" {
+				if errorLine != -1 {
+					t.Fatalf("expected no error line, got %d", errorLine)
+				}
+				return
+			}
+			lines := strings.Split(stripANSI(content), "\n")
+			if errorLine < 0 || errorLine >= len(lines) {
+				t.Fatalf("error line %d out of range of %d lines", errorLine, len(lines))
+			}
+			if lines[errorLine] != tt.expected {
+				t.Errorf("expected line %d to be %q, got %q", errorLine, tt.expected, lines[errorLine])
+			}
+		})
+	}
+}
+
+func TestRollupConsumerHealth(t *testing.T) {
+	healthy := []maestro.ConditionSummary{{Type: "Applied", Status: "True"}, {Type: "Available", Status: "True"}}
+	tests := []struct {
+		name     string
+		works    []maestro.ResourceBundleSummary
+		expected consumerHealth
+	}{
+		{name: "no works", expected: consumerHealthy},
+		{
+			name:     "all healthy",
+			works:    []maestro.ResourceBundleSummary{{Conditions: healthy}, {Conditions: healthy}},
+			expected: consumerHealthy,
+		},
+		{
+			name: "pending work",
+			works: []maestro.ResourceBundleSummary{
+				{Conditions: healthy},
+				{Conditions: []maestro.ConditionSummary{{Type: "Applied", Status: "True"}}},
+			},
+			expected: consumerHealthMixed,
+		},
+		{
+			name:     "work without conditions",
+			works:    []maestro.ResourceBundleSummary{{Conditions: healthy}, {}},
+			expected: consumerHealthMixed,
+		},
+		{
+			name: "failing work outranks pending ones",
+			works: []maestro.ResourceBundleSummary{
+				{},
+				{Conditions: []maestro.ConditionSummary{{Type: "Applied", Status: "True"}, {Type: "Available", Status: "False"}}},
+			},
+			expected: consumerHealthFailing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rollupConsumerHealth(tt.works); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSearchKeysEditQuery(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.consumers = []maestro.ConsumerInfo{{Name: "a"}, {Name: "b"}}
+	m.searching = true
+	m.searchInput.Focus()
+	// A mouse click can move focus off the detail panel while searching
+	m.focused = panelConsumers
+
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("nb")},
+		{Type: tea.KeyLeft},
+		{Type: tea.KeyRunes, Runes: []rune("j")},
+		{Type: tea.KeyDown},
+	}
+	for _, key := range keys {
+		updated, _ := m.Update(key)
+		m = updated.(Model)
+	}
+
+	if m.searchText != "njb" {
+		t.Errorf("expected keys to edit the query to %q, got %q", "njb", m.searchText)
+	}
+	if m.consumerCursor != 0 {
+		t.Errorf("expected the consumer cursor to stay put, got %d", m.consumerCursor)
+	}
+	if m.showCreateConsumer {
+		t.Error("expected n to be typed into the query, not open the create-consumer form")
+	}
+}
+
+func TestPanelAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		layout   string
+		width    int
+		focused  focusedPanel
+		x, y     int
+		expected focusedPanel
+	}{
+		{name: "split consumers", layout: layoutSplit, width: 80, x: 5, y: 2, expected: panelConsumers},
+		{name: "split manifests", layout: layoutSplit, width: 80, x: 5, y: 30, expected: panelManifests},
+		{name: "split detail", layout: layoutSplit, width: 80, x: 40, y: 2, expected: panelDetail},
+		{
+			name: "stacked is the focused panel everywhere", layout: layoutStacked, width: 200,
+			focused: panelManifests, x: 150, y: 2, expected: panelManifests,
+		},
+		{name: "auto splits wide terminals", layout: layoutAuto, width: 160, x: 100, y: 2, expected: panelDetail},
+		{
+			name: "auto stacks narrow terminals", layout: layoutAuto, width: 70,
+			focused: panelConsumers, x: 60, y: 2, expected: panelConsumers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(maestro.ClientConfig{}, Options{Layout: tt.layout})
+			m.width, m.height = tt.width, 50
+			m.focused = tt.focused
+			if got := m.panelAt(tt.x, tt.y); got != tt.expected {
+				t.Errorf("panelAt(%d, %d) = %v, expected %v", tt.x, tt.y, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCopyConsumerManifestsCancelled(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	if err != nil {
+		t.Fatalf("failed to load demo fixtures: %v", err)
+	}
+	m := New(maestro.ClientConfig{}, Options{Fixtures: fixtures})
+	consumer := fixtures.Consumers[0].Name
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	msg := m.copyConsumerManifestsCmd(ctx, consumer)()
+	copied, ok := msg.(consumerCopiedMsg)
+	if !ok || !errors.Is(copied.err, context.Canceled) {
+		t.Fatalf("expected a cancelled copy, got %#v", msg)
+	}
+
+	// A late result of the cancelled copy must not end a newer one
+	m.consumerCopyCancel = func() {}
+	m.loading = true
+	updated, _ := m.Update(copied)
+	if m = updated.(Model); !m.loading || m.consumerCopyCancel == nil {
+		t.Error("expected the cancelled copy's result to be ignored")
+	}
+}
+
+func TestEmptyConsumers(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.width, m.height = 120, 40
+	m.manifests = []maestro.ResourceBundleSummary{{Name: "stale"}}
+	updated, _ := m.Update(connectedMsg{})
+	m = updated.(Model)
+
+	if !strings.Contains(m.statusMsg, "no consumers") {
+		t.Errorf("expected the status to say the server has no consumers, got %q", m.statusMsg)
+	}
+	if m.manifests != nil {
+		t.Error("expected ManifestWorks of a previous connection to be cleared")
+	}
+	if !strings.Contains(stripANSI(m.View()), "press [n] to create one") {
+		t.Error("expected the consumers panel to offer creating a consumer")
+	}
+
+	// None of the keys that act on the selected consumer may index the empty list
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyEnter}, {Type: tea.KeyRunes, Runes: []rune("e")}, {Type: tea.KeyRunes, Runes: []rune("d")},
+		{Type: tea.KeyRunes, Runes: []rune("i")}, {Type: tea.KeyRunes, Runes: []rune("Y")}, {Type: tea.KeyCtrlY},
+		{Type: tea.KeyRunes, Runes: []rune("j")}, {Type: tea.KeyTab}, {Type: tea.KeyEnter},
+		{Type: tea.KeyRunes, Runes: []rune("r")}, {Type: tea.KeyRunes, Runes: []rune("d")}, {Type: tea.KeyTab},
+		{Type: tea.KeyRunes, Runes: []rune("w")}, {Type: tea.KeyRunes, Runes: []rune("D")},
+	}
+	for _, key := range keys {
+		updated, _ := m.Update(key)
+		m = updated.(Model)
+		_ = m.View()
+	}
+	if m.showConfirm || m.showConsumerInfo {
+		t.Error("expected no consumer modal to open without consumers")
+	}
+
+	updated, _ = m.Update(tea.MouseMsg{X: 5, Y: 3, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	_ = updated.(Model).View()
+}
+
+func TestWriteClipboard(t *testing.T) {
+	defer func(orig func(string) error) { clipboardWriteAll = orig }(clipboardWriteAll)
+
+	// Each write runs the writer once, even after it was abandoned
+	stuck := make(chan struct{})
+	var abandoned sync.WaitGroup
+	abandoned.Add(2)
+	clipboardWriteAll = func(string) error {
+		defer abandoned.Done()
+		<-stuck
+		return nil
+	}
+	if err := writeClipboard(context.Background(), "x", 10*time.Millisecond); err == nil {
+		t.Error("expected a stuck clipboard to time out")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := writeClipboard(ctx, "x", time.Minute); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected a cancelled write, got %v", err)
+	}
+	close(stuck)
+	abandoned.Wait()
+
+	var written string
+	clipboardWriteAll = func(s string) error {
+		written = s
+		return nil
+	}
+	if err := writeClipboard(context.Background(), "hello", time.Minute); err != nil || written != "hello" {
+		t.Errorf("expected the content to be written, got %q, %v", written, err)
+	}
+}
+
+func TestLargeCopyWarning(t *testing.T) {
+	tests := []struct {
+		name     string
+		warnSize int
+		size     int
+		expected bool
+	}{
+		{name: "below the limit", warnSize: 100, size: 100},
+		{name: "above the limit", warnSize: 100, size: 101, expected: true},
+		{name: "disabled", size: 1 << 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(maestro.ClientConfig{}, Options{ClipboardWarnSize: tt.warnSize})
+			updated, _ := m.Update(clipboardMsg{size: tt.size})
+			if got := updated.(Model).alertMsg != ""; got != tt.expected {
+				t.Errorf("expected warning %v, got alert %q", tt.expected, updated.(Model).alertMsg)
+			}
+		})
+	}
+}
+
+func TestSearchPeek(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.width, m.height = 120, 40
+	m.focused = panelDetail
+	content := []string{"a", "error one", "error two", "b", "c", "d", "e", "f", "g", "last error"}
+	m.setDetailContent(strings.Join(content, "\n"))
+	m.searching = true
+	m.searchInput.SetValue("error")
+	m.searchText = "error"
+	m.rebuildSearch()
+
+	press := func(key tea.KeyMsg) {
+		updated, _ := m.Update(key)
+		m = updated.(Model)
+	}
+	press(tea.KeyMsg{Type: tea.KeyCtrlG})
+	if !m.showSearchPeek {
+		t.Fatal("expected Ctrl+G to open the search peek")
+	}
+	if got := m.peekLines(); !slices.Equal(got, []int{1, 2, 9}) {
+		t.Errorf("expected match lines [1 2 9], got %v", got)
+	}
+	view := stripANSI(m.viewSearchPeekModal())
+	for _, want := range []string{" 1 a", " 4 b", "--", "10 last error"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected the peek to contain %q, got:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, " 6 d") {
+		t.Errorf("expected lines far from any match to be left out, got:\n%s", view)
+	}
+
+	// j is a peek key, not part of the query behind it
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showSearchPeek {
+		t.Error("expected Enter to close the peek")
+	}
+	if m.searchText != "error" {
+		t.Errorf("expected the query to stay %q, got %q", "error", m.searchText)
+	}
+	if line := m.searchMatches[m.searchCurrent].line; line != 9 {
+		t.Errorf("expected Enter to make the match on line 9 current, got line %d", line)
+	}
+}
+
+func TestIconLegend(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.width, m.height = 120, 40
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	m = updated.(Model)
+	if !m.showLegend {
+		t.Fatal("expected ? to open the icon legend")
+	}
+
+	// Every icon drawn in the lists must be explained
+	legend := m.viewLegendModal()
+	icons := []string{
+		workStatusIcon(workHealthy), workStatusIcon(workFailing), workStatusIcon(workTerminating),
+		workStatusIcon(workUnknown), conditionIcon("True"), conditionIcon("False"), conditionIcon(""),
+		consumerHealthIcon(consumerHealthy), consumerHealthIcon(consumerHealthMixed),
+		consumerHealthIcon(consumerHealthFailing), consumerHealthIcon(consumerHealthUnknown),
+	}
+	for _, icon := range icons {
+		if !strings.Contains(legend, icon) {
+			t.Errorf("expected the legend to explain %q", stripANSI(icon))
+		}
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEscape})
+	if updated.(Model).showLegend {
+		t.Error("expected Esc to close the legend")
+	}
+}
+
+func TestPinnedDetail(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	if err != nil {
+		t.Fatalf("failed to load demo fixtures: %v", err)
+	}
+	m := New(maestro.ClientConfig{}, Options{Fixtures: fixtures})
+	m.screen = screenMain
+	m.width, m.height = 160, 40
+	consumer := fixtures.Consumers[0].Name
+	m.manifests, _ = fixtures.ListManifestWorksHTTP(context.Background(), consumer)
+	if len(m.manifests) < 2 {
+		t.Fatalf("expected demo consumer %q to have several ManifestWorks", consumer)
+	}
+	m.focused = panelManifests
+	updated, _ := m.Update(m.loadDetail(m.manifests[0])())
+	m = updated.(Model)
+
+	press := func(key string) tea.Cmd {
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+		return cmd
+	}
+	press("p")
+	if m.pinnedManifestID != m.manifests[0].ID {
+		t.Fatalf("expected p to pin %q, got %q", m.manifests[0].ID, m.pinnedManifestID)
+	}
+	if cmd := press("j"); cmd != nil {
+		t.Error("expected moving the cursor not to load a detail while pinned")
+	}
+	if !strings.Contains(m.viewDetail(80, 30), "[PINNED]") {
+		t.Error("expected the detail title to show the pin")
+	}
+
+	// A load that was in flight for another ManifestWork must not replace it
+	updated, _ = m.Update(m.loadDetail(m.manifests[1])())
+	if m = updated.(Model); m.detail.ID != m.manifests[0].ID {
+		t.Errorf("expected the pinned detail to stay, got %q", m.detail.ID)
+	}
+
+	cmd := press("p")
+	if m.pinnedManifestID != "" || cmd == nil {
+		t.Fatal("expected p to unpin and load the ManifestWork under the cursor")
+	}
+	updated, _ = m.Update(cmd())
+	if m = updated.(Model); m.detail.ID != m.manifests[1].ID {
+		t.Errorf("expected the detail to catch up with the cursor, got %q", m.detail.ID)
+	}
+}
+
+func TestSearchHighlightsWideText(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		query    string
+		expected []string // highlighted text of each match
+	}{
+		{name: "CJK", line: "エラー: 配置错误，配置无效", query: "配置", expected: []string{"配置", "配置"}},
+		{name: "emoji", line: "status 🚀 ready 🚀🚀", query: "🚀", expected: []string{"🚀", "🚀", "🚀"}},
+		{name: "after wide runes", line: "名前 Deployment/web", query: "web", expected: []string{"web"}},
+		{name: "mixed case", line: "Ölfeld ÖLFELD", query: "öl", expected: []string{"Öl", "ÖL"}},
+		{name: "Kelvin sign", line: "\u212A8s cluster k8s", query: "k8s", expected: []string{"\u212A8s", "k8s"}},
+	}
+
+	highlightRe := regexp.MustCompile("\x1b\\[4[23]m(.*?)\x1b\\[49m")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(maestro.ClientConfig{}, Options{})
+			// Colored like the detail views, so offsets must skip escape codes
+			runes := []rune(tt.line)
+			half := len(runes) / 2
+			m.setDetailContent("\x1b[32m" + string(runes[:half]) + "\x1b[0m" + string(runes[half:]))
+			m.searchInput.SetValue(tt.query)
+			m.searchText = tt.query
+			m.rebuildSearch()
+
+			highlighted := strings.Join(m.searchHighlighted, "\n")
+			if !utf8.ValidString(highlighted) {
+				t.Fatalf("highlighting split a character: %q", highlighted)
+			}
+			var got []string
+			for _, match := range highlightRe.FindAllStringSubmatch(highlighted, -1) {
+				got = append(got, stripANSI(match[1]))
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("expected highlights %q, got %q", tt.expected, got)
+			}
+			if stripANSI(highlighted) != tt.line {
+				t.Errorf("expected highlighting to keep the text, got %q", stripANSI(highlighted))
+			}
+		})
+	}
+}
+
+func TestClipboardRawViewsAreClean(t *testing.T) {
+	raw := map[string]interface{}{
+		"name": "web",
+		"manifests": []interface{}{
+			map[string]interface{}{
+				"kind": "ConfigMap",
+				"data": map[string]interface{}{
+					"script":  "echo one  \necho two\t\n",
+					"padded":  "value   ",
+					"colored": "\x1b[31mred\x1b[0m",
+					"empty":   "",
+				},
+			},
+		},
+	}
+	msg := newDetailLoadedMsg(&maestro.ManifestWorkDetails{Name: "web"}, raw, false)
+
+	m := New(maestro.ClientConfig{}, Options{})
+	updated, _ := m.Update(msg)
+	m = updated.(Model)
+
+	for _, mode := range []detailViewMode{viewModeJSON, viewModeYAML} {
+		m.detailViewMode = mode
+		content := m.clipboardContent()
+		if strings.Contains(content, "\x1b") {
+			t.Errorf("mode %d: expected no escape sequences, got %q", mode, content)
+		}
+		for i, line := range strings.Split(content, "\n") {
+			if strings.TrimRight(line, " \t\r") != line {
+				t.Errorf("mode %d: line %d has trailing whitespace: %q", mode, i+1, line)
+			}
+		}
+		if !strings.HasSuffix(content, "\n") || strings.HasSuffix(content, "\n\n") {
+			t.Errorf("mode %d: expected a single trailing newline, got %q", mode, content)
+		}
+
+		// The cleanup must not change the document itself
+		var parsed map[string]interface{}
+		if err := sigyaml.Unmarshal([]byte(content), &parsed); err != nil {
+			t.Fatalf("mode %d: failed to parse copied content: %v", mode, err)
+		}
+		data := parsed["manifests"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})
+		for key, want := range raw["manifests"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{}) {
+			if data[key] != want {
+				t.Errorf("mode %d: expected %s to round-trip as %q, got %q", mode, key, want, data[key])
+			}
+		}
+	}
+}
+
+func TestStatusSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		detail   maestro.ManifestWorkDetails
+		expected string
+	}{
+		{
+			name: "failing work condition",
+			detail: maestro.ManifestWorkDetails{
+				Name: "web", ConsumerName: "agent1", Version: 3, UpdatedAt: "2024-01-02T10:00:00Z",
+				Conditions: []maestro.ConditionSummary{
+					{Type: "Applied", Status: "True"},
+					{Type: "Available", Status: "False", Reason: "ResourceNotAvailable", Message: "0/3 replicas available."},
+				},
+			},
+			expected: `ManifestWork "web" on consumer "agent1" is Degraded (version 3, updated 2024-01-02T10:00:00Z).` +
+				` First failing condition: Available=False, reason ResourceNotAvailable: 0/3 replicas available.`,
+		},
+		{
+			name: "failing resource condition",
+			detail: maestro.ManifestWorkDetails{
+				Name: "web", ConsumerName: "agent1",
+				Conditions: []maestro.ConditionSummary{{Type: "Applied", Status: "True"}, {Type: "Available", Status: "True"}},
+				ResourceStatus: []maestro.ResourceStatusInfo{{
+					Kind: "Deployment", Name: "web",
+					Conditions: []maestro.ConditionSummary{{Type: "Available", Status: "Unknown"}},
+				}},
+			},
+			expected: `ManifestWork "web" on consumer "agent1" is Healthy.` +
+				` First failing condition: Available=Unknown on Deployment/web.`,
+		},
+		{
+			name: "healthy",
+			detail: maestro.ManifestWorkDetails{
+				Name: "web", ConsumerName: "agent1", Version: 1,
+				Conditions: []maestro.ConditionSummary{{Type: "Applied", Status: "True"}, {Type: "Available", Status: "True"}},
+			},
+			expected: `ManifestWork "web" on consumer "agent1" is Healthy (version 1). All conditions are met.`,
+		},
+		{
+			name:     "no conditions",
+			detail:   maestro.ManifestWorkDetails{Name: "web", ConsumerName: "agent1"},
+			expected: `ManifestWork "web" on consumer "agent1" is Unknown. No conditions reported yet.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusSummary(&tt.detail); got != tt.expected {
+				t.Errorf("expected\n%s\ngot\n%s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWatchReconnect(t *testing.T) {
+	for failures, expected := range map[int]time.Duration{
+		1: 2 * time.Second, 2: 4 * time.Second, 3: 8 * time.Second, 6: time.Minute, 40: time.Minute,
+	} {
+		if got := watchRetryDelay(failures); got != expected {
+			t.Errorf("expected a %s delay after %d failures, got %s", expected, failures, got)
+		}
+	}
+
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.width, m.height = 160, 40
+	m.watching = true
+	m.setDetailContent("web")
+	failure := detailErrMsg{errors.New("connection refused")}
+	for i := 1; i < maxWatchFailures; i++ {
+		updated, cmd := m.Update(failure)
+		m = updated.(Model)
+		if cmd == nil {
+			t.Fatalf("failure %d: expected a retry to be scheduled", i)
+		}
+		if m.errMsg2 != "" || !strings.HasPrefix(m.statusMsg, "Reconnecting") {
+			t.Fatalf("failure %d: expected a reconnecting notice and no error, got status %q, error %q",
+				i, m.statusMsg, m.errMsg2)
+		}
+	}
+	if !strings.Contains(stripANSI(m.View()), "reconnecting…") {
+		t.Error("expected the detail panel to show the reconnecting indicator")
+	}
+
+	updated, cmd := m.Update(failure)
+	m = updated.(Model)
+	if cmd == nil || !strings.Contains(m.errMsg2, "connection refused") {
+		t.Fatalf("expected the error after %d failures while retrying, got %q", maxWatchFailures, m.errMsg2)
+	}
+
+	updated, _ = m.Update(newDetailLoadedMsg(&maestro.ManifestWorkDetails{Name: "web"}, map[string]interface{}{}, false))
+	m = updated.(Model)
+	if m.watchFailures != 0 || m.errMsg2 != "" || m.statusMsg != "Reconnected — watch resumed" {
+		t.Errorf("expected a successful poll to resume the watch, got failures %d, status %q, error %q",
+			m.watchFailures, m.statusMsg, m.errMsg2)
+	}
+}
+
+func TestStaleBadgeWithoutWatch(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.width, m.height = 160, 40
+	loaded := newDetailLoadedMsg(&maestro.ManifestWorkDetails{Name: "web"}, map[string]interface{}{}, false)
+	updated, _ := m.Update(loaded)
+	m = updated.(Model)
+
+	updated, cmd := m.Update(detailErrMsg{errors.New("connection refused")})
+	m = updated.(Model)
+	if cmd == nil || !m.staleTicking {
+		t.Fatal("expected a failed refresh with watch off to schedule a stale tick")
+	}
+	updated, cmd = m.Update(staleTickMsg(m.detailLoadedAt.Add(time.Minute)))
+	m = updated.(Model)
+	if cmd == nil || m.detailStaleFor() != time.Minute {
+		t.Fatalf("expected the tick to age the stale badge and schedule the next, got %s", m.detailStaleFor())
+	}
+	if !strings.Contains(stripANSI(m.View()), "stale") {
+		t.Error("expected the detail panel to show the stale badge")
+	}
+
+	updated, _ = m.Update(loaded)
+	m = updated.(Model)
+	updated, cmd = m.Update(staleTickMsg(time.Now()))
+	if m = updated.(Model); cmd != nil || m.staleTicking {
+		t.Error("expected the stale ticks to stop once a refresh succeeds")
+	}
+}
+
+func TestManifestNameLines(t *testing.T) {
+	tests := []struct {
+		name, text string
+		wrap       bool
+		expected   []string
+		cut        bool
+	}{
+		{name: "fits", text: "ab", expected: []string{"ab  "}},
+		{name: "truncated", text: "abcdef", expected: []string{"abc…"}, cut: true},
+		{name: "wrapped", text: "abcdef", wrap: true, expected: []string{"abcd", "ef  "}},
+		{name: "too long to wrap", text: "abcdefghij", wrap: true, expected: []string{"abcd", "efg…"}, cut: true},
+		{name: "wide runes", text: "配置配置配", wrap: true, expected: []string{"配置", "配… "}, cut: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, cut := manifestNameLines(tt.text, 4, tt.wrap)
+			if !slices.Equal(lines, tt.expected) || cut != tt.cut {
+				t.Errorf("expected %q (cut %v), got %q (cut %v)", tt.expected, tt.cut, lines, cut)
+			}
+		})
+	}
+}
+
+func TestLongManifestNames(t *testing.T) {
+	long := "hyperfleet-cluster-west-1-nodepool-workers-b" // wider than the name column, fits in two rows
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.width, m.height = 100, 30
+	m.focused = panelManifests
+	m.consumers = []maestro.ConsumerInfo{{Name: "agent1"}}
+	m.manifests = []maestro.ResourceBundleSummary{{Name: "short"}, {Name: long}, {Name: "third"}}
+	m.manifestCursor = 1
+
+	bounds := m.panelBounds(panelManifests)
+	footer := m.selectedNameFooter(bounds.w-4, bounds.h-4)
+	if strings.Join(footer, "") != long {
+		t.Fatalf("expected the truncated selected name in full under the list, got %q", footer)
+	}
+	if view := stripANSI(m.View()); !strings.Contains(view, footer[0]) || !strings.Contains(view, "…") {
+		t.Error("expected the list to truncate the name and show it in full below")
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	m = updated.(Model)
+	if !m.wrapNames {
+		t.Fatal("expected z to wrap long names")
+	}
+	if footer := m.selectedNameFooter(bounds.w-4, bounds.h-4); footer != nil {
+		t.Errorf("expected no footer once the name wraps in full, got %q", footer)
+	}
+
+	// The wrapped name takes two rows, so the fourth row holds the third work
+	const headerRows = 3
+	updated, _ = m.mouseClickManifest(bounds.y+headerRows+3, bounds.y)
+	if got := updated.(Model).manifestCursor; got != 2 {
+		t.Errorf("expected a click below the wrapped name to select the third work, got %d", got)
+	}
+}
+
+// recordingClient serves the demo fixtures and records the detail and delete
+// calls the TUI makes.
+type recordingClient struct {
+	*Fixtures
+	detailErr error
+	details   []string
+	deletes   []string
+}
+
+func (c *recordingClient) GetResourceBundleDetailsHTTP(
+	ctx context.Context,
+	id, consumer string,
+) (*maestro.ManifestWorkDetails, map[string]interface{}, error) {
+	c.details = append(c.details, id)
+	if c.detailErr != nil {
+		return nil, nil, c.detailErr
+	}
+	return c.Fixtures.GetResourceBundleDetailsHTTP(ctx, id, consumer)
+}
+
+func (c *recordingClient) DeleteResourceBundleByID(ctx context.Context, id string, expectedVersion int32) error {
+	c.deletes = append(c.deletes, fmt.Sprintf("%s@%d", id, expectedVersion))
+	return nil
+}
+
+func TestInjectedClient(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	if err != nil {
+		t.Fatalf("failed to load demo fixtures: %v", err)
+	}
+	client := &recordingClient{Fixtures: fixtures}
+	m := New(maestro.ClientConfig{}, Options{Client: client})
+	m.width, m.height = 160, 40
+	if !m.connected() {
+		t.Fatal("expected an injected client to count as connected")
+	}
+
+	// Init connects through the client instead of showing the connect screen
+	batch, ok := m.Init()().(tea.BatchMsg)
+	if !ok {
+		t.Fatal("expected Init to return a batch")
+	}
+	for _, cmd := range batch {
+		if msg, ok := cmd().(connectedMsg); ok {
+			updated, _ := m.Update(msg)
+			m = updated.(Model)
+		}
+	}
+	if m.screen != screenMain || len(m.consumers) != len(fixtures.Consumers) {
+		t.Fatalf("expected the main screen with %d consumers, got screen %d with %d",
+			len(fixtures.Consumers), m.screen, len(m.consumers))
+	}
+
+	updated, _ := m.Update(m.loadManifests(m.consumers[0].Name)())
+	m = updated.(Model)
+	if len(m.manifests) == 0 {
+		t.Fatal("expected the ManifestWorks of the first consumer")
+	}
+	mw := m.manifests[0]
+	updated, _ = m.Update(m.loadDetail(mw)())
+	m = updated.(Model)
+	if m.detail == nil || m.detail.ID != mw.ID || m.detailContent == "" {
+		t.Fatalf("expected the detail of %s, got %+v", mw.ID, m.detail)
+	}
+
+	// A failed reload keeps the detail already shown
+	content := m.detailContent
+	client.detailErr = errors.New("connection refused")
+	updated, _ = m.Update(m.loadDetail(mw)())
+	m = updated.(Model)
+	if m.detailContent != content || !m.detailFailed {
+		t.Error("expected a failed reload to keep the detail and mark it stale")
+	}
+	if !slices.Equal(client.details, []string{mw.ID, mw.ID}) {
+		t.Errorf("expected two detail requests for %s, got %v", mw.ID, client.details)
+	}
+
+	updated, cmd := m.Update(m.deleteManifestCmd(mw.ID, mw.Version)())
+	m = updated.(Model)
+	if expected := fmt.Sprintf("%s@%d", mw.ID, mw.Version); !slices.Equal(client.deletes, []string{expected}) {
+		t.Errorf("expected delete %s, got %v", expected, client.deletes)
+	}
+	if cmd == nil || m.statusMsg != "ManifestWork deleted" {
+		t.Errorf("expected the ManifestWorks to reload after a delete, got status %q", m.statusMsg)
+	}
+}
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestDeterministicView(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	if err != nil {
+		t.Fatalf("failed to load demo fixtures: %v", err)
+	}
+	m := New(maestro.ClientConfig{}, Options{Fixtures: fixtures, Deterministic: true})
+	m.width, m.height = 120, 32
+	for _, msg := range []tea.Msg{
+		connectClientCmd(fixtures)(),
+		m.loadManifests(fixtures.Consumers[0].Name)(),
+	} {
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	updated, _ := m.Update(m.loadDetail(m.manifests[0])())
+	m = updated.(Model)
+
+	// A loading spinner on another frame must not change the output
+	m.loading = true
+	view := m.View()
+	m.spinnerIdx = 3
+	if again := m.View(); again != view {
+		t.Fatal("expected the same output on another spinner frame")
+	}
+	if strings.Contains(view, "\x1b[") {
+		t.Fatal("expected no escape sequences in deterministic output")
+	}
+	for i, line := range strings.Split(view, "\n") {
+		if w := lipgloss.Width(line); w > m.width {
+			t.Errorf("line %d is %d columns wide on a %d-column screen: %q", i+1, w, m.width, line)
+		}
+	}
+
+	golden := filepath.Join("testdata", "main_screen.golden")
+	if *updateGolden {
+		if err := os.MkdirAll("testdata", 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(view), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read %s (run with -update to create it): %v", golden, err)
+	}
+	if view != string(expected) {
+		t.Errorf("main screen differs from %s (run with -update if the change is intended):\n%s", golden, view)
+	}
+}
+
+func TestJumpToFailing(t *testing.T) {
+	healthy := []maestro.ConditionSummary{{Type: "Applied", Status: "True"}, {Type: "Available", Status: "True"}}
+	failed := []maestro.ConditionSummary{{Type: "Applied", Status: "False"}}
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.focused = panelManifests
+	m.pinnedManifestID = "pinned" // keeps jumps from loading a detail without a client
+	m.manifests = []maestro.ResourceBundleSummary{
+		{ID: "1", Name: "a", Conditions: healthy},
+		{ID: "2", Name: "b", Conditions: failed},
+		{ID: "3", Name: "c", Conditions: healthy},
+		{ID: "4", Name: "d", Conditions: failed},
+		{ID: "5", Name: "e", DeletedAt: "2024-01-02T10:00:00Z", Conditions: failed},
+	}
+
+	press := func(key string) {
+		t.Helper()
+		updated, _ := m.handleManifestsKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+	}
+	for _, step := range []struct {
+		key    string
+		cursor int
+		status string
+	}{
+		{"]", 1, "Failing 1/2: b"},
+		{"]", 3, "Failing 2/2: d"},
+		{"]", 1, "Failing 1/2: b"}, // wraps, skipping the terminating e
+		{"[", 3, "Failing 2/2: d"},
+		{"[", 1, "Failing 1/2: b"},
+	} {
+		press(step.key)
+		if m.manifestCursor != step.cursor || m.statusMsg != step.status {
+			t.Fatalf("after %q expected cursor %d and status %q, got %d and %q",
+				step.key, step.cursor, step.status, m.manifestCursor, m.statusMsg)
+		}
+	}
+
+	m.manifests = m.manifests[:1]
+	m.manifestCursor = 0
+	press("]")
+	if m.manifestCursor != 0 || m.statusMsg != "No failing ManifestWorks" {
+		t.Errorf("expected the cursor to stay without failing ManifestWorks, got %d and %q", m.manifestCursor, m.statusMsg)
+	}
+}
+
+func TestDetailBookmarks(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.focused = panelDetail
+	var lines []string
+	for i := 0; i < 200; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i+1))
+	}
+	fill := func() {
+		m.setDetailContent(strings.Join(lines, "\n"))
+		m.viewport.SetContent(m.detailContent)
+	}
+	load := func(id string) {
+		t.Helper()
+		detail := &maestro.ManifestWorkDetails{ID: id, Name: id}
+		updated, _ := m.Update(newDetailLoadedMsg(detail, map[string]interface{}{}, false))
+		m = updated.(Model)
+		fill()
+	}
+	press := func(msg tea.KeyMsg) {
+		t.Helper()
+		updated, _ := m.handleDetailKey(msg)
+		m = updated.(Model)
+	}
+	jump := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'\''}}
+	bookmark := tea.KeyMsg{Type: tea.KeyCtrlB}
+
+	load("a")
+	press(jump)
+	if !strings.HasPrefix(m.statusMsg, "No bookmarks") {
+		t.Fatalf("expected a hint without bookmarks, got %q", m.statusMsg)
+	}
+	for _, offset := range []int{120, 30} {
+		m.viewport.SetYOffset(offset)
+		press(bookmark)
+	}
+	m.viewport.SetYOffset(0)
+	for _, expected := range []int{30, 120, 30} {
+		press(jump)
+		if m.viewport.YOffset != expected {
+			t.Fatalf("expected a jump to offset %d, got %d (%s)", expected, m.viewport.YOffset, m.statusMsg)
+		}
+	}
+
+	// Bookmarks belong to the view mode they were set in
+	m.cycleDetailViewMode()
+	press(jump)
+	if !strings.HasPrefix(m.statusMsg, "No bookmarks") {
+		t.Errorf("expected no bookmarks in another view mode, got %q", m.statusMsg)
+	}
+	for m.detailViewMode != viewModeFormatted {
+		m.cycleDetailViewMode()
+	}
+	fill()
+
+	// Pressing Ctrl+B on a bookmark removes it
+	m.viewport.SetYOffset(30)
+	press(bookmark)
+	if !slices.Equal(m.modeBookmarks(), []int{120}) {
+		t.Errorf("expected only the bookmark at 120 left, got %v", m.modeBookmarks())
+	}
+
+	// A refresh of the same ManifestWork keeps them, another one clears them
+	load("a")
+	if len(m.bookmarks) != 1 {
+		t.Errorf("expected a refresh to keep the bookmarks, got %v", m.bookmarks)
+	}
+	load("b")
+	if len(m.bookmarks) != 0 {
+		t.Errorf("expected switching ManifestWorks to clear the bookmarks, got %v", m.bookmarks)
+	}
+}
+
+func TestBaselinePerConsumer(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen, m.focused = screenMain, panelManifests
+	m.consumers = []maestro.ConsumerInfo{{Name: "agent1"}, {Name: "agent2"}}
+	work := func(consumer, status string) maestro.ResourceBundleSummary {
+		return maestro.ResourceBundleSummary{
+			ID: consumer + "-web", Name: "web", ConsumerName: consumer,
+			Conditions: []maestro.ConditionSummary{{Type: "Available", Status: status}},
+		}
+	}
+	load := func(works ...maestro.ResourceBundleSummary) {
+		t.Helper()
+		updated, _ := m.Update(manifestsLoadedMsg{consumer: works[0].ConsumerName, manifests: works})
+		m = updated.(Model)
+	}
+
+	load(work("agent1", "False"))
+	load(work("agent2", "False"))
+	// Back on agent1 after its work became available: still changed
+	load(work("agent1", "True"))
+	if !m.changedSinceBaseline(m.manifests[0]) {
+		t.Fatal("expected agent1's baseline to survive loading agent2")
+	}
+
+	updated, _ := m.handleManifestsKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	m = updated.(Model)
+	load(work("agent1", "True"))
+	if m.changedSinceBaseline(m.manifests[0]) {
+		t.Error("expected r to take a fresh baseline for agent1")
+	}
+	if !m.changedSinceBaseline(work("agent2", "True")) {
+		t.Error("expected r to leave agent2's baseline alone")
+	}
+}
+
+func TestSwitchConsumerKeepsName(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.focused = panelManifests
+	m.pinnedManifestID = "pinned" // keeps selections from loading a detail without a client
+	m.consumers = []maestro.ConsumerInfo{{Name: "agent1"}, {Name: "agent2"}, {Name: "agent3"}}
+	m.manifests = []maestro.ResourceBundleSummary{
+		{ID: "1", Name: "a", ConsumerName: "agent1"},
+		{ID: "2", Name: "b", ConsumerName: "agent1"},
+	}
+	m.manifestCursor = 1
+
+	press := func(key tea.KeyType) {
+		t.Helper()
+		updated, _ := m.handleMainKey(tea.KeyMsg{Type: key})
+		m = updated.(Model)
+	}
+	load := func(consumer string, names ...string) {
+		t.Helper()
+		var manifests []maestro.ResourceBundleSummary
+		for i, name := range names {
+			manifests = append(manifests, maestro.ResourceBundleSummary{
+				ID: fmt.Sprintf("%s-%d", consumer, i), Name: name, ConsumerName: consumer,
+			})
+		}
+		updated, _ := m.Update(manifestsLoadedMsg{consumer: consumer, manifests: manifests})
+		m = updated.(Model)
+	}
+
+	press(tea.KeyCtrlN)
+	if m.consumerCursor != 1 || !m.loading {
+		t.Fatalf("expected agent2 to be loading, got cursor %d (loading %v)", m.consumerCursor, m.loading)
+	}
+	load("agent2", "x", "y", "b")
+	if sel := m.selectedManifest(); sel == nil || sel.ID != "agent2-2" {
+		t.Fatalf("expected agent2's b to be selected, got %+v", sel)
+	}
+
+	press(tea.KeyCtrlN)
+	load("agent3", "x", "y")
+	if m.consumerCursor != 2 || m.manifestCursor != 0 {
+		t.Fatalf("expected the first of agent3's works, got consumer %d, work %d", m.consumerCursor, m.manifestCursor)
+	}
+
+	press(tea.KeyCtrlN) // wraps to agent1
+	if m.consumerCursor != 0 {
+		t.Fatalf("expected Ctrl+N to wrap to agent1, got %d", m.consumerCursor)
+	}
+	load("agent1", "a", "b")
+	press(tea.KeyCtrlP) // wraps back to agent3
+	if m.consumerCursor != 2 {
+		t.Fatalf("expected Ctrl+P to wrap to agent3, got %d", m.consumerCursor)
+	}
+}
+
+func TestDetailMarkdown(t *testing.T) {
+	d := &maestro.ManifestWorkDetails{
+		ID: "abc", Name: "web", ConsumerName: "agent1", Version: 2, CreatedAt: "2024-01-02T10:00:00Z",
+		Manifests: []maestro.ManifestInfo{{Kind: "Deployment", Namespace: "default", Name: "web"}},
+		Conditions: []maestro.ConditionSummary{
+			{Type: "Applied", Status: "True", Reason: "AppliedManifestComplete"},
+			{Type: "Available", Status: "False", Reason: "NotReady", Message: "0/3 ready |\n waiting"},
+		},
+		ResourceStatus: []maestro.ResourceStatusInfo{
+			{Kind: "Deployment", Name: "web", Conditions: []maestro.ConditionSummary{{Type: "Available", Status: "False"}}},
+			{Kind: "ConfigMap", Name: "settings"},
+		},
+	}
+	expected := "### ManifestWork `web` on `agent1`\n\n" +
+		"- **Status:** Degraded\n" +
+		"- **ID:** abc\n" +
+		"- **Version:** 2\n" +
+		"- **Created:** 2024-01-02T10:00:00Z\n" +
+		"\n#### Conditions\n\n" +
+		"| Type | Status | Reason | Message |\n" +
+		"|------|--------|--------|---------|\n" +
+		"| Applied | True | AppliedManifestComplete |  |\n" +
+		"| Available | False | NotReady | 0/3 ready \\| waiting |\n" +
+		"\n#### Manifests (1)\n\n" +
+		"- `Deployment/default/web`\n" +
+		"\n#### `Deployment/web`\n\n" +
+		"| Type | Status | Reason | Message |\n" +
+		"|------|--------|--------|---------|\n" +
+		"| Available | False |  |  |\n"
+	if got := detailMarkdown(d); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+	if strings.Contains(detailMarkdown(&maestro.ManifestWorkDetails{Name: "new"}), "| Type |") {
+		t.Error("expected no conditions table for a work without conditions")
+	}
+}
+
+func TestIdleRefreshKeepsSelection(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := New(maestro.ClientConfig{}, Options{Fixtures: fixtures, IdleRefresh: time.Minute})
+	updated, _ := m.Update(connectedMsg{client: fixtures, consumers: fixtures.Consumers})
+	m = updated.(Model)
+	m.consumerCursor = 1
+	selected := m.consumers[1].Name
+
+	// A tick from before the last connect is dropped
+	if _, cmd := m.Update(idleRefreshTickMsg{gen: m.idleRefreshGen - 1}); cmd != nil {
+		t.Error("expected a stale tick to do nothing")
+	}
+	updated, cmd := m.Update(idleRefreshTickMsg{gen: m.idleRefreshGen})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("expected a tick to refresh and schedule the next one")
+	}
+
+	// The refreshed list has a new consumer in front of the selected one
+	consumers := append([]maestro.ConsumerInfo{{Name: "aaa-new"}}, fixtures.Consumers...)
+	updated, _ = m.Update(consumersRefreshedMsg{consumers: consumers})
+	m = updated.(Model)
+	if got := m.consumers[m.consumerCursor].Name; got != selected {
+		t.Errorf("expected %s to stay selected, got %s", selected, got)
+	}
+
+	// A vanished consumer leaves the cursor in range
+	updated, _ = m.Update(consumersRefreshedMsg{consumers: consumers[:1]})
+	m = updated.(Model)
+	if m.consumerCursor != 0 {
+		t.Errorf("expected the cursor to be clamped to 0, got %d", m.consumerCursor)
+	}
+
+	updated, _ = m.Update(consumersRefreshedMsg{err: errors.New("connection refused")})
+	m = updated.(Model)
+	if !strings.Contains(m.errMsg2, "connection refused") || len(m.consumers) != 1 {
+		t.Errorf("expected a failed refresh to report and keep the list, got %q and %d consumers",
+			m.errMsg2, len(m.consumers))
+	}
+}
+
+func TestTransitionBadge(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	if got := m.transitionBadge(); got != "" {
+		t.Errorf("expected no badge without a detail, got %q", got)
+	}
+	m.detail = &maestro.ManifestWorkDetails{
+		Conditions: []maestro.ConditionSummary{{Type: "Applied", LastTransitionTime: "2024-01-02T10:00:00Z"}},
+		ResourceStatus: []maestro.ResourceStatusInfo{{
+			Conditions: []maestro.ConditionSummary{{Type: "Available", LastTransitionTime: "2024-01-02T10:03:00Z"}},
+		}},
+	}
+	for _, tt := range []struct {
+		now      time.Time
+		expected string
+	}{
+		{time.Date(2024, 1, 2, 10, 3, 3, 0, time.UTC), "changed 3s ago"},
+		{time.Date(2024, 1, 2, 10, 15, 0, 0, time.UTC), "stable for 12m"},
+		{time.Date(2024, 1, 2, 10, 2, 0, 0, time.UTC), "changed 0s ago"}, // clock skew
+	} {
+		m.now = tt.now
+		if got := stripANSI(m.transitionBadge()); got != tt.expected {
+			t.Errorf("at %s expected %q, got %q", tt.now.Format(time.TimeOnly), tt.expected, got)
+		}
+	}
+	m.opts.Deterministic = true
+	if got := m.transitionBadge(); got != "" {
+		t.Errorf("expected no badge in deterministic output, got %q", got)
+	}
+}
+
+func TestToggleSecrets(t *testing.T) {
+	raw := map[string]interface{}{
+		"name": "creds",
+		"manifests": []map[string]interface{}{
+			{"kind": "ConfigMap", "data": map[string]interface{}{"mode": "plain"}},
+			{
+				"kind":       "Secret",
+				"data":       map[string]interface{}{"password": "aHVudGVyMg==", "key": "/w=="},
+				"stringData": map[string]interface{}{"token": "abc"},
+			},
+		},
+	}
+	detail := &maestro.ManifestWorkDetails{ID: "id-1", Name: "creds", ConsumerName: "agent1"}
+	loaded := newDetailLoadedMsg(detail, raw, false)
+	m := New(maestro.ClientConfig{}, Options{})
+	updated, _ := m.Update(loaded)
+	m = updated.(Model)
+	if strings.Contains(m.detailRawJSON, "aHVudGVyMg==") || strings.Contains(m.detailRawJSON, "abc") {
+		t.Fatalf("expected Secret values to be redacted, got:\n%s", m.detailRawJSON)
+	}
+	if !strings.Contains(m.detailRawJSON, `"plain"`) {
+		t.Errorf("expected ConfigMap data to be shown, got:\n%s", m.detailRawJSON)
+	}
+
+	m.toggleSecrets()
+	if m.secretsShownID != "" || !strings.Contains(m.statusMsg, "--show-secrets") {
+		t.Fatalf("expected the reveal to be refused without --show-secrets, got %q", m.statusMsg)
+	}
+
+	m.opts.ShowSecrets = true
+	m.toggleSecrets()
+	if m.secretsShownID != "id-1" || !strings.Contains(m.statusMsg, "agent1/creds") {
+		t.Fatalf("expected the reveal to be reported, got %q", m.statusMsg)
+	}
+	for _, want := range []string{`"hunter2"`, `"/w=="`, `"abc"`} {
+		if !strings.Contains(m.detailRawJSON, want) {
+			t.Errorf("expected %s in the revealed JSON, got:\n%s", want, m.detailRawJSON)
+		}
+	}
+	secret := raw["manifests"].([]map[string]interface{})[1]
+	if secret["data"].(map[string]interface{})["password"] != "aHVudGVyMg==" {
+		t.Error("expected the raw detail to be left unchanged")
+	}
+
+	other := newDetailLoadedMsg(&maestro.ManifestWorkDetails{ID: "id-2", Name: "other"}, raw, false)
+	updated, _ = m.Update(other)
+	m = updated.(Model)
+	if m.secretsShownID != "" || strings.Contains(m.detailRawJSON, "hunter2") {
+		t.Error("expected opening another ManifestWork to redact again")
+	}
+}
+
+func TestSecretsRedactedInCopies(t *testing.T) {
+	raw := map[string]interface{}{
+		"id":   "id-1",
+		"name": "creds",
+		"manifests": []map[string]interface{}{
+			{"kind": "Secret", "data": map[string]interface{}{"password": "aHVudGVyMg=="}},
+		},
+	}
+	detail := &maestro.ManifestWorkDetails{ID: "id-1", Name: "creds", ConsumerName: "agent1"}
+	var audit bytes.Buffer
+	m := New(maestro.ClientConfig{}, Options{
+		ShowSecrets: true,
+		AuditLogger: logger.New(logger.Config{Level: "warn", Format: "json", Writer: &audit}),
+	})
+	updated, _ := m.Update(newDetailLoadedMsg(detail, raw, false))
+	m = updated.(Model)
+
+	manifest, err := m.manifestWorkYAML()
+	if err != nil {
+		t.Fatalf("failed to render the ManifestWork: %v", err)
+	}
+	if strings.Contains(manifest, "aHVudGVyMg==") || !strings.Contains(manifest, redactedValue) {
+		t.Errorf("expected M to copy redacted Secret values, got:\n%s", manifest)
+	}
+	exported, _ := json.Marshal(m.exportedBundle(raw))
+	if strings.Contains(string(exported), "aHVudGVyMg==") {
+		t.Errorf("expected the export to redact Secret values, got %s", exported)
+	}
+
+	m.toggleSecrets()
+	var record map[string]interface{}
+	if err := json.Unmarshal(audit.Bytes(), &record); err != nil {
+		t.Fatalf("expected one JSON audit record, got %q: %v", audit.String(), err)
+	}
+	for key, want := range map[string]interface{}{
+		"msg": "Secret values revealed in the TUI", "consumer": "agent1", "manifestwork": "creds", "id": "id-1",
+	} {
+		if record[key] != want {
+			t.Errorf("expected %s=%v in the audit record, got %v", key, want, record[key])
+		}
+	}
+
+	manifest, _ = m.manifestWorkYAML()
+	if !strings.Contains(manifest, "aHVudGVyMg==") {
+		t.Errorf("expected M to copy the revealed Secret as base64, got:\n%s", manifest)
+	}
+	exported, _ = json.Marshal(m.exportedBundle(raw))
+	if !strings.Contains(string(exported), "aHVudGVyMg==") {
+		t.Errorf("expected the export to keep the revealed Secret, got %s", exported)
+	}
+	other := map[string]interface{}{"id": "id-2", "manifests": raw["manifests"]}
+	if exported, _ = json.Marshal(m.exportedBundle(other)); strings.Contains(string(exported), "aHVudGVyMg==") {
+		t.Errorf("expected the export to redact other ManifestWorks, got %s", exported)
+	}
+}
+
+func TestSingleConsumerCollapsed(t *testing.T) {
+	m := New(maestro.ClientConfig{}, Options{})
+	m.screen = screenMain
+	m.width, m.height = 120, 40
+	m.consumers = []maestro.ConsumerInfo{{Name: "agent1"}}
+	m.manifests = []maestro.ResourceBundleSummary{{Name: "web", ConsumerName: "agent1"}}
+	m.focused = panelManifests
+
+	if r := m.panelBounds(panelManifests); r.y != 0 || r.h != m.height-1 {
+		t.Errorf("expected the ManifestWorks panel to take the whole column, got %+v", r)
+	}
+	if got := m.panelAt(5, 3); got != panelManifests {
+		t.Errorf("expected a click at the top of the column to hit the ManifestWorks panel, got %v", got)
+	}
+	view := stripANSI(m.View())
+	if strings.Contains(view, "Consumers (") || !strings.Contains(view, "ManifestWorks of agent1 (1)") {
+		t.Errorf("expected only the ManifestWorks panel, titled with the consumer, got:\n%s", view)
+	}
+
+	m.focused = panelConsumers
+	if m.panelBounds(panelManifests).y == 0 || !strings.Contains(stripANSI(m.View()), "Consumers (") {
+		t.Error("expected the consumers panel to come back while focused")
+	}
+
+	m.focused = panelManifests
+	m.opts.AlwaysShowConsumers = true
+	if m.panelBounds(panelManifests).y == 0 {
+		t.Error("expected --always-show-consumers to keep the consumers panel")
+	}
+}
+
+func TestConnectCommand(t *testing.T) {
+	m := New(maestro.ClientConfig{
+		HTTPEndpoint:      "https://maestro.example.com",
+		GRPCEndpoint:      "maestro-grpc:8090",
+		GRPCInsecure:      true,
+		GRPCClientToken:   "s3cr3t",
+		APIPrefix:         maestro.DefaultAPIPrefix,
+		ImpersonateUser:   "jane",
+		ImpersonateGroups: []string{"ops team"},
+	}, Options{})
+	expected := "maestro-cli tui --http-endpoint=https://maestro.example.com --grpc-endpoint=maestro-grpc:8090" +
+		" --grpc-insecure --grpc-client-token='<token>' --as=jane --as-group='ops team'"
+	if got := m.connectCommand(); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	m.opts.Fixtures = &Fixtures{}
+	if got := m.connectCommand(); got != "maestro-cli tui --demo" {
+		t.Errorf("expected the demo command, got %q", got)
+	}
+}
+
+// pagedClient serves the fixture consumers two per page.
+type pagedClient struct{ *Fixtures }
+
+func (c pagedClient) ListConsumersPage(_ context.Context, page int) ([]maestro.ConsumerInfo, int, error) {
+	start := min(2*(page-1), len(c.Consumers))
+	return c.Consumers[start:min(start+2, len(c.Consumers))], len(c.Consumers), nil
+}
+
+func TestConsumerPages(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	if err != nil {
+		t.Fatalf("failed to load demo fixtures: %v", err)
+	}
+	if len(fixtures.Consumers) != 3 {
+		t.Fatalf("expected 3 demo consumers, got %d", len(fixtures.Consumers))
+	}
+	client := pagedClient{fixtures}
+	m := New(maestro.ClientConfig{}, Options{Client: client})
+	m.width, m.height = 120, 40
+	updated, _ := m.Update(connectClientCmd(client)())
+	m = updated.(Model)
+	if len(m.consumers) != 2 || m.consumersTotal != 3 {
+		t.Fatalf("expected the first page of 2 out of 3 consumers, got %d of %d", len(m.consumers), m.consumersTotal)
+	}
+	if view := stripANSI(m.View()); !strings.Contains(view, "loading 2/3…") {
+		t.Errorf("expected a loading indicator in the consumers panel, got:\n%s", view)
+	}
+
+	m.consumerCursor = 1
+	stale := m.loadConsumerPage(2)
+	updated, _ = m.Update(m.loadConsumerPage(2)())
+	m = updated.(Model)
+	if len(m.consumers) != 3 || m.consumersTotal != 3 || m.consumerCursor != 1 {
+		t.Fatalf("expected all 3 consumers with the selection kept, got %d of %d, cursor %d",
+			len(m.consumers), m.consumersTotal, m.consumerCursor)
+	}
+	if strings.Contains(stripANSI(m.View()), "loading") {
+		t.Error("expected the loading indicator to go once every page is in")
+	}
+
+	// A page of a list loaded again since is dropped
+	updated, _ = m.Update(consumersLoadedMsg{consumers: fixtures.Consumers[:2], total: 3})
+	m = updated.(Model)
+	updated, _ = m.Update(stale())
+	if m = updated.(Model); len(m.consumers) != 2 {
+		t.Errorf("expected the stale page to be ignored, got %d consumers", len(m.consumers))
+	}
+
+	// A server that ignores the page number sends the first page again
+	updated, cmd := m.Update(consumerPageMsg{gen: m.consumerPageGen, page: 2, consumers: fixtures.Consumers[:2], total: 3})
+	m = updated.(Model)
+	if cmd != nil || m.consumersTotal != 2 {
+		t.Errorf("expected a page with nothing new to end the loading, got total %d", m.consumersTotal)
+	}
+}
+
+func TestListWatchToggle(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	if err != nil {
+		t.Fatalf("failed to load demo fixtures: %v", err)
+	}
+	m := New(maestro.ClientConfig{}, Options{Fixtures: fixtures})
+	for _, msg := range []tea.Msg{connectClientCmd(fixtures)(), m.loadManifests(fixtures.Consumers[0].Name)()} {
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	m.screen, m.focused = screenMain, panelManifests
+
+	// On, off and on again before the first tick fires
+	for range 3 {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+		m = updated.(Model)
+	}
+	if !m.watchingList {
+		t.Fatal("expected the list watch to be on")
+	}
+	updated, cmd := m.Update(listWatchTickMsg{gen: m.listWatchGen - 1})
+	if m = updated.(Model); cmd != nil || m.listRefreshing {
+		t.Error("expected the tick of the earlier list watch to be dropped")
+	}
+	updated, _ = m.Update(listWatchTickMsg{gen: m.listWatchGen})
+	if m = updated.(Model); !m.listRefreshing {
+		t.Error("expected the current tick to refresh the list")
+	}
+}
+
+func TestCollapseArrays(t *testing.T) {
+	ips := make([]interface{}, 12)
+	for i := range ips {
+		ips[i] = fmt.Sprintf("10.0.0.%d", i)
+	}
+	raw := map[string]interface{}{
+		"name": "web",
+		"manifests": []map[string]interface{}{{
+			"kind":     "Service",
+			"metadata": map[string]interface{}{"finalizers": []interface{}{"a", "b"}},
+			"spec":     map[string]interface{}{"externalIPs": ips},
+		}},
+	}
+	m := New(maestro.ClientConfig{}, Options{})
+	updated, _ := m.Update(newDetailLoadedMsg(&maestro.ManifestWorkDetails{ID: "id-1", Name: "web"}, raw, false))
+	m = updated.(Model)
+	m.detailViewMode = viewModeJSON
+
+	m.toggleCollapseArrays()
+	shown := m.shownContent()
+	if !strings.Contains(shown, `"externalIPs": "[ 12 items ]"`) || strings.Contains(shown, "10.0.0.11") {
+		t.Errorf("expected the long IP list to be collapsed, got:\n%s", shown)
+	}
+	if !strings.Contains(shown, `"b"`) {
+		t.Errorf("expected the short finalizer list to be kept, got:\n%s", shown)
+	}
+	if !strings.Contains(m.clipboardContent(), "10.0.0.11") {
+		t.Error("expected the clipboard to keep the full array")
+	}
+
+	// The setting carries over to the next detail loaded
+	updated, _ = m.Update(newDetailLoadedMsg(&maestro.ManifestWorkDetails{ID: "id-2", Name: "web"}, raw, false))
+	if m = updated.(Model); strings.Contains(stripANSI(m.detailYAML), "10.0.0.11") {
+		t.Error("expected a newly loaded detail to be collapsed too")
+	}
+
+	m.toggleCollapseArrays()
+	if !strings.Contains(m.shownContent(), "10.0.0.11") {
+		t.Error("expected the arrays to be expanded again")
+	}
+}
@@ -2,148 +2,388 @@
 package tui
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
+// Theme is a named color palette from which every TUI style is derived.
+type Theme struct {
+	Name string
+
+	Primary   lipgloss.TerminalColor // modals, buttons
+	Secondary lipgloss.TerminalColor // panel titles, section headers, help keys
+	Success   lipgloss.TerminalColor
+	Warning   lipgloss.TerminalColor
+	Error     lipgloss.TerminalColor
+	Muted     lipgloss.TerminalColor // borders, secondary text
+	Focused   lipgloss.TerminalColor // focused borders, inputs and buttons
+	Selected  lipgloss.TerminalColor // selected list row background
+
+	Text         lipgloss.TerminalColor // list items and detail values
+	SelectedText lipgloss.TerminalColor // text on the Selected background
+	ButtonText   lipgloss.TerminalColor // text on Primary and Focused backgrounds
+	AlertText    lipgloss.TerminalColor // text on the Warning alert background
+	Badge        lipgloss.TerminalColor // view mode badge
+	Backdrop     lipgloss.TerminalColor // whitespace behind modals
+
+	// Syntax highlighting
+	JSONKey    lipgloss.TerminalColor
+	JSONString lipgloss.TerminalColor
+	JSONNumber lipgloss.TerminalColor
+	JSONBool   lipgloss.TerminalColor
+	JSONPunct  lipgloss.TerminalColor
+}
+
+// darkTheme is tuned for dark terminal backgrounds.
+var darkTheme = Theme{
+	Name:         "dark",
+	Primary:      lipgloss.Color("#7C3AED"), // purple
+	Secondary:    lipgloss.Color("#06B6D4"), // cyan
+	Success:      lipgloss.Color("#10B981"), // green
+	Warning:      lipgloss.Color("#F59E0B"), // amber
+	Error:        lipgloss.Color("#EF4444"), // red
+	Muted:        lipgloss.Color("#6B7280"), // gray
+	Focused:      lipgloss.Color("#3B82F6"), // blue
+	Selected:     lipgloss.Color("#1E40AF"), // dark blue
+	Text:         lipgloss.Color("#E5E7EB"),
+	SelectedText: lipgloss.Color("#FFFFFF"),
+	ButtonText:   lipgloss.Color("#FFFFFF"),
+	AlertText:    lipgloss.Color("#111827"),
+	Badge:        lipgloss.Color("#94A3B8"),
+	Backdrop:     lipgloss.Color("#1F2937"),
+	JSONKey:      lipgloss.Color("#7DD3FC"), // sky blue
+	JSONString:   lipgloss.Color("#86EFAC"), // green
+	JSONNumber:   lipgloss.Color("#FDE68A"), // amber
+	JSONBool:     lipgloss.Color("#C4B5FD"), // lavender
+	JSONPunct:    lipgloss.Color("#94A3B8"), // slate
+}
+
+// lightTheme is tuned for light terminal backgrounds: darker foregrounds and a
+// pale selection so selected rows and muted text keep their contrast.
+var lightTheme = Theme{
+	Name:         "light",
+	Primary:      lipgloss.Color("#6D28D9"),
+	Secondary:    lipgloss.Color("#0E7490"),
+	Success:      lipgloss.Color("#047857"),
+	Warning:      lipgloss.Color("#B45309"),
+	Error:        lipgloss.Color("#B91C1C"),
+	Muted:        lipgloss.Color("#4B5563"),
+	Focused:      lipgloss.Color("#1D4ED8"),
+	Selected:     lipgloss.Color("#BFDBFE"),
+	Text:         lipgloss.Color("#111827"),
+	SelectedText: lipgloss.Color("#000000"),
+	ButtonText:   lipgloss.Color("#FFFFFF"),
+	AlertText:    lipgloss.Color("#FFFFFF"),
+	Badge:        lipgloss.Color("#475569"),
+	Backdrop:     lipgloss.Color("#E5E7EB"),
+	JSONKey:      lipgloss.Color("#0369A1"),
+	JSONString:   lipgloss.Color("#15803D"),
+	JSONNumber:   lipgloss.Color("#A16207"),
+	JSONBool:     lipgloss.Color("#7E22CE"),
+	JSONPunct:    lipgloss.Color("#64748B"),
+}
+
+// themes lists the built-in presets; the first is the default. "auto" picks the
+// dark or light color for each style from the terminal's detected background.
+var themes = []Theme{
+	adaptiveTheme("auto", lightTheme, darkTheme),
+	darkTheme,
+	lightTheme,
+}
+
+// adaptiveTheme combines two presets into one whose colors are
+// lipgloss.AdaptiveColor values, resolved against the terminal background.
+func adaptiveTheme(name string, light, dark Theme) Theme {
+	c := func(l, d lipgloss.TerminalColor) lipgloss.TerminalColor {
+		return lipgloss.AdaptiveColor{Light: string(l.(lipgloss.Color)), Dark: string(d.(lipgloss.Color))}
+	}
+	return Theme{
+		Name:         name,
+		Primary:      c(light.Primary, dark.Primary),
+		Secondary:    c(light.Secondary, dark.Secondary),
+		Success:      c(light.Success, dark.Success),
+		Warning:      c(light.Warning, dark.Warning),
+		Error:        c(light.Error, dark.Error),
+		Muted:        c(light.Muted, dark.Muted),
+		Focused:      c(light.Focused, dark.Focused),
+		Selected:     c(light.Selected, dark.Selected),
+		Text:         c(light.Text, dark.Text),
+		SelectedText: c(light.SelectedText, dark.SelectedText),
+		ButtonText:   c(light.ButtonText, dark.ButtonText),
+		AlertText:    c(light.AlertText, dark.AlertText),
+		Badge:        c(light.Badge, dark.Badge),
+		Backdrop:     c(light.Backdrop, dark.Backdrop),
+		JSONKey:      c(light.JSONKey, dark.JSONKey),
+		JSONString:   c(light.JSONString, dark.JSONString),
+		JSONNumber:   c(light.JSONNumber, dark.JSONNumber),
+		JSONBool:     c(light.JSONBool, dark.JSONBool),
+		JSONPunct:    c(light.JSONPunct, dark.JSONPunct),
+	}
+}
+
+// ThemeNames returns the names of the built-in themes, default first.
+func ThemeNames() []string {
+	names := make([]string, len(themes))
+	for i, t := range themes {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// IsTheme reports whether name is a built-in theme, matched case-insensitively
+// as Options.Theme is.
+func IsTheme(name string) bool {
+	return themeIndex(name) >= 0
+}
+
+// themeIndex returns the index of the named theme, or -1.
+func themeIndex(name string) int {
+	for i, t := range themes {
+		if strings.EqualFold(t.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Styles are derived from the active theme by applyTheme.
 var (
-	// Colors
-	colorPrimary   = lipgloss.Color("#7C3AED") // purple
-	colorSecondary = lipgloss.Color("#06B6D4") // cyan
-	colorSuccess   = lipgloss.Color("#10B981") // green
-	colorWarning   = lipgloss.Color("#F59E0B") // amber
-	colorError     = lipgloss.Color("#EF4444") // red
-	colorMuted     = lipgloss.Color("#6B7280") // gray
-	colorFocused   = lipgloss.Color("#3B82F6") // blue
-	colorSelected  = lipgloss.Color("#1E40AF") // dark blue
+	colorBackdrop lipgloss.TerminalColor
 
 	// Panel border styles
+	styleBorderNormal  lipgloss.Style
+	styleBorderFocused lipgloss.Style
+
+	// Panel title styles
+	stylePanelTitle        lipgloss.Style
+	stylePanelTitleFocused lipgloss.Style
+	stylePanelTitleWatch   lipgloss.Style
+
+	// List item styles
+	styleItemNormal   lipgloss.Style
+	styleItemSelected lipgloss.Style
+
+	// Status indicator styles
+	styleStatusOK   lipgloss.Style
+	styleStatusWarn lipgloss.Style
+	styleStatusErr  lipgloss.Style
+	styleStatusUnk  lipgloss.Style
+
+	// Condition badge styles
+	styleCondTrue  lipgloss.Style
+	styleCondFalse lipgloss.Style
+	styleCondUnk   lipgloss.Style
+
+	// Detail section styles
+	styleDetailKey    lipgloss.Style
+	styleDetailValue  lipgloss.Style
+	styleDetailHeader lipgloss.Style
+
+	// Help bar
+	styleHelpKey  lipgloss.Style
+	styleHelpDesc lipgloss.Style
+
+	// Status bar
+	styleStatusMsg lipgloss.Style
+	styleErrMsg    lipgloss.Style
+	styleAlertMsg  lipgloss.Style
+
+	// Modal styles
+	styleModal         lipgloss.Style
+	styleModalTitle    lipgloss.Style
+	styleInputFocused  lipgloss.Style
+	styleInputNormal   lipgloss.Style
+	styleButton        lipgloss.Style
+	styleButtonFocused lipgloss.Style
+
+	// Watch indicator
+	styleWatchBadge lipgloss.Style
+
+	// Stale-content indicator (shown in the detail title)
+	styleStaleBadge lipgloss.Style
+
+	// Pinned-detail indicator (shown in the detail title)
+	stylePinnedBadge lipgloss.Style
+
+	// Terminating ManifestWork: list icon and detail title badge
+	styleTerminating      lipgloss.Style
+	styleTerminatingBadge lipgloss.Style
+
+	// Filter indicator
+	styleFilterActive lipgloss.Style
+
+	// View mode badge (shown in detail panel title)
+	styleJSONModeBadge lipgloss.Style
+
+	// ── Syntax-highlighting styles ────────────────────────────────────────────
+
+	styleJSONKey    lipgloss.Style // keys
+	styleJSONString lipgloss.Style // strings
+	styleJSONNumber lipgloss.Style // numbers
+	styleJSONBool   lipgloss.Style // true/false
+	styleJSONNull   lipgloss.Style // null/~
+	styleJSONPunct  lipgloss.Style // punctuation
+
+	// ── Search bar styles ─────────────────────────────────────────────────────
+
+	styleSearchBar       lipgloss.Style
+	styleSearchBarActive lipgloss.Style // search bar while it has keyboard focus
+	styleSearchPrompt    lipgloss.Style // "/ " prompt and cursor of the focused search bar
+	styleSearchCount     lipgloss.Style
+	styleSearchNoMatch   lipgloss.Style
+)
+
+func init() {
+	applyTheme(themes[0])
+}
+
+// applyTheme rebuilds every package style from t. Content rendered before the
+// call keeps its old colors until it is rendered again.
+func applyTheme(t Theme) {
+	colorBackdrop = t.Backdrop
+
 	styleBorderNormal = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(colorMuted)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Muted)
 
 	styleBorderFocused = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(colorFocused)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Focused)
 
-	// Panel title styles
 	stylePanelTitle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(colorSecondary)
+		Bold(true).
+		Foreground(t.Secondary)
 
 	stylePanelTitleFocused = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(colorFocused)
+		Bold(true).
+		Foreground(t.Focused)
 
 	stylePanelTitleWatch = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(colorWarning)
+		Bold(true).
+		Foreground(t.Warning)
 
-	// List item styles
 	styleItemNormal = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#E5E7EB"))
+		Foreground(t.Text)
 
 	styleItemSelected = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(lipgloss.Color("#FFFFFF")).
-				Background(colorSelected)
+		Bold(true).
+		Foreground(t.SelectedText).
+		Background(t.Selected)
 
-	// Status indicator styles
-	styleStatusOK  = lipgloss.NewStyle().Foreground(colorSuccess)
-	styleStatusErr = lipgloss.NewStyle().Foreground(colorError)
-	styleStatusUnk = lipgloss.NewStyle().Foreground(colorMuted)
+	styleStatusOK = lipgloss.NewStyle().Foreground(t.Success)
+	styleStatusWarn = lipgloss.NewStyle().Foreground(t.Warning)
+	styleStatusErr = lipgloss.NewStyle().Foreground(t.Error)
+	styleStatusUnk = lipgloss.NewStyle().Foreground(t.Muted)
 
-	// Condition badge styles
-	styleCondTrue  = lipgloss.NewStyle().Foreground(colorSuccess).Bold(true)
-	styleCondFalse = lipgloss.NewStyle().Foreground(colorError).Bold(true)
-	styleCondUnk   = lipgloss.NewStyle().Foreground(colorMuted)
+	styleCondTrue = lipgloss.NewStyle().Foreground(t.Success).Bold(true)
+	styleCondFalse = lipgloss.NewStyle().Foreground(t.Error).Bold(true)
+	styleCondUnk = lipgloss.NewStyle().Foreground(t.Muted)
 
-	// Detail section styles
 	styleDetailKey = lipgloss.NewStyle().
-			Foreground(colorMuted).
-			Bold(true)
+		Foreground(t.Muted).
+		Bold(true)
 
 	styleDetailValue = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#E5E7EB"))
+		Foreground(t.Text)
 
 	styleDetailHeader = lipgloss.NewStyle().
-				Foreground(colorSecondary).
-				Bold(true).
-				Underline(true)
+		Foreground(t.Secondary).
+		Bold(true).
+		Underline(true)
 
-	// Help bar
 	styleHelpKey = lipgloss.NewStyle().
-			Foreground(colorSecondary).
-			Bold(true)
+		Foreground(t.Secondary).
+		Bold(true)
 
 	styleHelpDesc = lipgloss.NewStyle().
-			Foreground(colorMuted)
+		Foreground(t.Muted)
 
-	// Status bar
 	styleStatusMsg = lipgloss.NewStyle().
-			Foreground(colorSuccess)
+		Foreground(t.Success)
 
 	styleErrMsg = lipgloss.NewStyle().
-			Foreground(colorError)
+		Foreground(t.Error)
+
+	styleAlertMsg = lipgloss.NewStyle().
+		Foreground(t.AlertText).
+		Background(t.Warning).
+		Bold(true)
 
-	// Modal styles
 	styleModal = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(colorPrimary).
-			Padding(1, 2)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2)
 
 	styleModalTitle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(colorPrimary)
+		Bold(true).
+		Foreground(t.Primary)
 
 	styleInputFocused = lipgloss.NewStyle().
-				Foreground(colorFocused)
+		Foreground(t.Focused)
 
 	styleInputNormal = lipgloss.NewStyle().
-				Foreground(colorMuted)
+		Foreground(t.Muted)
 
 	styleButton = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(colorPrimary).
-			Padding(0, 2)
+		Bold(true).
+		Foreground(t.ButtonText).
+		Background(t.Primary).
+		Padding(0, 2)
 
 	styleButtonFocused = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(lipgloss.Color("#FFFFFF")).
-				Background(colorFocused).
-				Padding(0, 2)
+		Bold(true).
+		Foreground(t.ButtonText).
+		Background(t.Focused).
+		Padding(0, 2)
 
-	// Watch indicator
 	styleWatchBadge = lipgloss.NewStyle().
-			Foreground(colorWarning).
-			Bold(true)
+		Foreground(t.Warning).
+		Bold(true)
 
-	// Filter indicator
-	styleFilterActive = lipgloss.NewStyle().
-				Foreground(colorWarning)
+	styleStaleBadge = lipgloss.NewStyle().
+		Foreground(t.Error).
+		Bold(true)
 
-	// View mode badge (shown in detail panel title)
-	styleJSONModeBadge = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#94A3B8")).
-				Bold(false)
+	stylePinnedBadge = lipgloss.NewStyle().
+		Foreground(t.Focused).
+		Bold(true)
 
-	// ── Syntax-highlighting styles ────────────────────────────────────────────
+	styleTerminating = lipgloss.NewStyle().
+		Foreground(t.Error).
+		Bold(true)
 
-	styleJSONKey    = lipgloss.NewStyle().Foreground(lipgloss.Color("#7DD3FC")) // sky blue  — keys
-	styleJSONString = lipgloss.NewStyle().Foreground(lipgloss.Color("#86EFAC")) // green     — strings
-	styleJSONNumber = lipgloss.NewStyle().Foreground(lipgloss.Color("#FDE68A")) // amber     — numbers
-	styleJSONBool   = lipgloss.NewStyle().Foreground(lipgloss.Color("#C4B5FD")) // lavender  — true/false
-	styleJSONNull   = lipgloss.NewStyle().Foreground(colorMuted)                //            — null/~
-	styleJSONPunct  = lipgloss.NewStyle().Foreground(lipgloss.Color("#94A3B8")) // slate     — punctuation
+	styleTerminatingBadge = lipgloss.NewStyle().
+		Foreground(t.Error).
+		Bold(true).
+		Reverse(true)
 
-	// ── Search bar styles ─────────────────────────────────────────────────────
+	styleFilterActive = lipgloss.NewStyle().
+		Foreground(t.Warning)
 
-	styleSearchBar     = lipgloss.NewStyle().Foreground(colorFocused)
-	styleSearchCount   = lipgloss.NewStyle().Foreground(colorMuted)
-	styleSearchNoMatch = lipgloss.NewStyle().Foreground(colorError)
-)
+	styleJSONModeBadge = lipgloss.NewStyle().
+		Foreground(t.Badge).
+		Bold(false)
+
+	styleJSONKey = lipgloss.NewStyle().Foreground(t.JSONKey)
+	styleJSONString = lipgloss.NewStyle().Foreground(t.JSONString)
+	styleJSONNumber = lipgloss.NewStyle().Foreground(t.JSONNumber)
+	styleJSONBool = lipgloss.NewStyle().Foreground(t.JSONBool)
+	styleJSONNull = lipgloss.NewStyle().Foreground(t.Muted)
+	styleJSONPunct = lipgloss.NewStyle().Foreground(t.JSONPunct)
+
+	styleSearchBar = lipgloss.NewStyle().Foreground(t.Focused)
+	styleSearchBarActive = lipgloss.NewStyle().
+		Foreground(t.SelectedText).
+		Background(t.Selected)
+	styleSearchPrompt = lipgloss.NewStyle().
+		Foreground(t.Focused).
+		Background(t.Selected).
+		Bold(true)
+	styleSearchCount = lipgloss.NewStyle().Foreground(t.Muted)
+	styleSearchNoMatch = lipgloss.NewStyle().Foreground(t.Error)
+}
 
 // ─── Condition / status icons ─────────────────────────────────────────────────
 
@@ -159,29 +399,128 @@ func conditionIcon(status string) string {
 	}
 }
 
-// workStatusIcon returns a status icon for a ManifestWork based on its conditions
-func workStatusIcon(applied, available bool, hasConditions bool) string {
-	if !hasConditions {
+// workStatusIcon returns a status icon for a ManifestWork's state
+func workStatusIcon(state workState) string {
+	switch state {
+	case workHealthy:
+		return styleStatusOK.Render("✓")
+	case workFailing:
+		return styleStatusErr.Render("✗")
+	case workTerminating:
+		return styleTerminating.Render("⊘")
+	default:
 		return styleStatusUnk.Render("?")
 	}
-	if applied && available {
-		return styleStatusOK.Render("✓")
+}
+
+// endpointProbeIcon returns a reachability indicator for a configured endpoint
+func endpointProbeIcon(state probeState) string {
+	switch state {
+	case probeReachable:
+		return styleStatusOK.Render("●")
+	case probeUnreachable:
+		return styleStatusErr.Render("●")
+	default:
+		return styleStatusUnk.Render("○")
+	}
+}
+
+// consumerHealthIcon returns a badge for a consumer's health rollup: green,
+// amber or red, or "?" until its ManifestWorks have been loaded
+func consumerHealthIcon(health consumerHealth) string {
+	switch health {
+	case consumerHealthy:
+		return styleStatusOK.Render("●")
+	case consumerHealthMixed:
+		return styleStatusWarn.Render("●")
+	case consumerHealthFailing:
+		return styleStatusErr.Render("●")
+	default:
+		return styleStatusUnk.Render("?")
+	}
+}
+
+// legendSection is one group of the icon legend: a heading and what each of
+// its icons means
+type legendSection struct {
+	title   string
+	entries [][2]string // icon, meaning
+}
+
+// iconLegend explains every status icon. The icons come from the functions
+// that draw them in the lists and the detail view, so the legend follows any
+// change to them.
+func iconLegend() []legendSection {
+	return []legendSection{
+		{title: "ManifestWorks", entries: [][2]string{
+			{workStatusIcon(workHealthy), "Applied and Available"},
+			{workStatusIcon(workFailing), "not ready: Applied or Available is not True"},
+			{workStatusIcon(workTerminating), "terminating: deletion requested"},
+			{workStatusIcon(workUnknown), "unknown: no conditions reported yet"},
+		}},
+		{title: "Conditions", entries: [][2]string{
+			{conditionIcon(condStatusTrue), "True"},
+			{conditionIcon("False"), "False"},
+			{conditionIcon("Unknown"), "Unknown or not reported"},
+		}},
+		{title: "Consumers", entries: [][2]string{
+			{consumerHealthIcon(consumerHealthy), "every ManifestWork healthy"},
+			{consumerHealthIcon(consumerHealthMixed), "some pending, terminating or without conditions"},
+			{consumerHealthIcon(consumerHealthFailing), "a ManifestWork reports Applied or Available False"},
+			{consumerHealthIcon(consumerHealthUnknown), "ManifestWorks not loaded yet"},
+		}},
 	}
-	return styleStatusErr.Render("✗")
 }
 
 // ─── JSON syntax colorizer ────────────────────────────────────────────────────
 
 // colorizeJSON applies terminal colors to a pretty-printed JSON string.
+// A string value that spans several lines (a raw newline inside the quotes) is
+// colored as a string up to its closing quote instead of having its later lines
+// parsed as keys and values.
 func colorizeJSON(src string) string {
 	lines := strings.Split(src, "\n")
 	out := make([]string, 0, len(lines))
+	inString := false
 	for _, line := range lines {
+		if inString {
+			var colored string
+			colored, inString = colorizeJSONContinuation(line)
+			out = append(out, colored)
+			continue
+		}
 		out = append(out, colorizeJSONLine(line))
+		inString = opensJSONString(line)
 	}
 	return strings.Join(out, "\n")
 }
 
+// colorizeJSONContinuation colors a line that starts inside a string value. It
+// reports whether the line ends still inside a string.
+func colorizeJSONContinuation(line string) (string, bool) {
+	end := findClosingQuote(line, 0)
+	if end < 0 {
+		return styleJSONString.Render(line), true
+	}
+	rest := line[end+1:]
+	return styleJSONString.Render(line[:end+1]) + colorizeJSONValue(rest), opensJSONString(rest)
+}
+
+// opensJSONString reports whether line ends inside an unterminated string,
+// honoring backslash escapes.
+func opensJSONString(line string) bool {
+	in := false
+	for i := 0; i < len(line); i++ {
+		switch {
+		case in && line[i] == '\\':
+			i++ // skip escaped char
+		case line[i] == '"':
+			in = !in
+		}
+	}
+	return in
+}
+
 func colorizeJSONLine(line string) string {
 	if line == "" {
 		return ""
@@ -236,7 +575,14 @@ func colorizeJSONValue(s string) string {
 	case s == "null":
 		colored = styleJSONNull.Render(s)
 	case len(s) > 0 && s[0] == '"':
-		colored = styleJSONString.Render(s)
+		// Only the quoted token is a string; anything after its closing quote
+		// (e.g. a stray bracket) is punctuation.
+		end := findClosingQuote(s, 1)
+		if end < 0 || end == len(s)-1 {
+			colored = styleJSONString.Render(s)
+		} else {
+			colored = styleJSONString.Render(s[:end+1]) + styleJSONPunct.Render(s[end+1:])
+		}
 	case s == "{" || s == "}" || s == "[" || s == "]" ||
 		s == "{}" || s == "[]" || s == "}," || s == "]," ||
 		strings.HasPrefix(s, "}") || strings.HasPrefix(s, "]"):
@@ -266,15 +612,79 @@ func findClosingQuote(s string, pos int) int {
 // ─── YAML syntax colorizer ────────────────────────────────────────────────────
 
 // colorizeYAML applies terminal colors to a YAML string.
+// The indented content of block scalars (`key: |` / `key: >`) is colored as
+// string text until the indentation drops back to the key's level.
 func colorizeYAML(src string) string {
 	lines := strings.Split(src, "\n")
 	out := make([]string, 0, len(lines))
+	blockIndent := -1 // indentation of the key whose block scalar is being read
 	for _, line := range lines {
+		if blockIndent >= 0 {
+			trimmed := strings.TrimLeft(line, " ")
+			if trimmed == "" || len(line)-len(trimmed) > blockIndent {
+				out = append(out, colorizeYAMLBlockLine(line))
+				continue
+			}
+			blockIndent = -1
+		}
 		out = append(out, colorizeYAMLLine(line))
+		blockIndent = yamlBlockScalarIndent(line)
 	}
 	return strings.Join(out, "\n")
 }
 
+// yamlBlockScalarIndent returns the indentation of the key (or list dash) when
+// line opens a block scalar, or -1 otherwise.
+func yamlBlockScalarIndent(line string) int {
+	trimmed := strings.TrimLeft(line, " ")
+	indent := len(line) - len(trimmed)
+	rest, keyIndent := trimmed, indent
+	if strings.HasPrefix(rest, "- ") {
+		rest = rest[2:]
+		keyIndent += 2
+	}
+	val := rest
+	if colonIdx := yamlMappingColon(rest); colonIdx > 0 {
+		if colonIdx == len(rest)-1 {
+			return -1
+		}
+		val = rest[colonIdx+2:]
+	} else {
+		keyIndent = indent // "- |": the content is indented past the dash
+	}
+	if isYAMLBlockHeader(val) {
+		return keyIndent
+	}
+	return -1
+}
+
+// isYAMLBlockHeader reports whether s is a block scalar indicator such as "|",
+// "|-", ">+" or "|2", optionally followed by a comment.
+func isYAMLBlockHeader(s string) bool {
+	if i := strings.Index(s, " #"); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimSpace(s)
+	if s == "" || (s[0] != '|' && s[0] != '>') || len(s) > 3 {
+		return false
+	}
+	for _, c := range s[1:] {
+		if c != '-' && c != '+' && (c < '1' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// colorizeYAMLBlockLine colors one content line of a block scalar.
+func colorizeYAMLBlockLine(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if trimmed == "" {
+		return line
+	}
+	return line[:len(line)-len(trimmed)] + styleJSONString.Render(trimmed)
+}
+
 func colorizeYAMLLine(line string) string {
 	if line == "" {
 		return ""
@@ -298,21 +708,83 @@ func colorizeYAMLLine(line string) string {
 	}
 
 	// Key: value  or  key:  (nested mapping)
-	colonIdx := strings.Index(rest, ": ")
-	if colonIdx > 0 {
+	if colonIdx := yamlMappingColon(rest); colonIdx > 0 {
 		key := rest[:colonIdx]
+		if colonIdx == len(rest)-1 {
+			return indent + listPrefix + styleJSONKey.Render(key) + styleJSONPunct.Render(":")
+		}
 		val := rest[colonIdx+2:]
 		return indent + listPrefix + styleJSONKey.Render(key) + styleJSONPunct.Render(": ") + colorizeYAMLValue(val)
 	}
-	if strings.HasSuffix(rest, ":") && !strings.Contains(rest[:len(rest)-1], ":") {
-		key := rest[:len(rest)-1]
-		return indent + listPrefix + styleJSONKey.Render(key) + styleJSONPunct.Render(":")
-	}
 
 	// Pure value (array scalar, etc.)
 	return indent + listPrefix + colorizeYAMLValue(rest)
 }
 
+// yamlMappingColon returns the index of the colon that ends the mapping key in
+// s, or -1 when s is a plain value. A quoted key may contain colons; for a plain
+// key the first ": " is the separator (YAML forbids ": " in plain scalars), so
+// colons inside values such as URLs and timestamps do not end the key.
+func yamlMappingColon(s string) int {
+	if s == "" {
+		return -1
+	}
+	switch s[0] {
+	case '"', '\'':
+		end := closingYAMLQuote(s)
+		if end < 0 || end+1 >= len(s) || s[end+1] != ':' {
+			return -1
+		}
+		if end+2 == len(s) || s[end+2] == ' ' {
+			return end + 1
+		}
+		return -1
+	case '{', '[':
+		return -1 // flow collection
+	}
+	if i := strings.Index(s, ": "); i > 0 {
+		return i
+	}
+	if strings.HasSuffix(s, ":") && !strings.Contains(s[:len(s)-1], ":") {
+		return len(s) - 1
+	}
+	return -1
+}
+
+// closingYAMLQuote returns the index of the quote closing the quoted scalar at
+// the start of s, or -1. Double quotes use backslash escapes; single quotes are
+// escaped by doubling them.
+func closingYAMLQuote(s string) int {
+	if s[0] == '"' {
+		return findClosingQuote(s, 1)
+	}
+	for i := 1; i < len(s); i++ {
+		if s[i] != '\'' {
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '\'' {
+			i++ // '' is an escaped quote
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+// isYAMLNumber reports whether s is a plain integer or float, as opposed to a
+// string that merely starts with a digit such as a timestamp or version.
+func isYAMLNumber(s string) bool {
+	// ParseFloat also accepts words like "Inf" and "NaN"
+	if c := s[0]; c != '-' && c != '+' && c != '.' && (c < '0' || c > '9') {
+		return false
+	}
+	if _, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return true
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
 // colorizeYAMLValue colorizes a YAML scalar value.
 func colorizeYAMLValue(s string) string {
 	if s == "" {
@@ -324,15 +796,18 @@ func colorizeYAMLValue(s string) string {
 	case "null", "~", "Null", "NULL":
 		return styleJSONNull.Render(s)
 	}
+	if isYAMLBlockHeader(s) {
+		return styleJSONPunct.Render(s)
+	}
 	// Quoted string
 	if (s[0] == '"' && s[len(s)-1] == '"') || (s[0] == '\'' && s[len(s)-1] == '\'') {
 		return styleJSONString.Render(s)
 	}
 	// Number
-	if len(s) > 0 && (s[0] == '-' || (s[0] >= '0' && s[0] <= '9')) {
+	if isYAMLNumber(s) {
 		return styleJSONNumber.Render(s)
 	}
-	// Plain string value
+	// Plain string value (including URLs, timestamps and versions)
 	return styleJSONString.Render(s)
 }
 
@@ -372,14 +847,13 @@ func buildCharMap(ansiStr string) []int {
 // [start, end) byte offsets into the *plain* (ANSI-stripped) version of the
 // line.  absIdxs[k] is the index of ranges[k] in the global searchMatches
 // slice; currentIdx is the currently selected match index.  The current match
-// is highlighted green (\x1b[42m); all others are amber (\x1b[43m).
-func injectBgHighlights(coloredLine string, ranges [][2]int, absIdxs []int, currentIdx int) string {
+// is highlighted green (\x1b[42m); all others are amber (\x1b[43m).  charMap
+// must be buildCharMap(coloredLine); callers cache it per line.
+func injectBgHighlights(coloredLine string, charMap []int, ranges [][2]int, absIdxs []int, currentIdx int) string {
 	if len(ranges) == 0 {
 		return coloredLine
 	}
 
-	charMap := buildCharMap(coloredLine)
-
 	var sb strings.Builder
 	prev := 0 // last written byte position in coloredLine
 
@@ -424,3 +898,17 @@ func injectBgHighlights(coloredLine string, ranges [][2]int, absIdxs []int, curr
 	sb.WriteString(coloredLine[prev:])
 	return sb.String()
 }
+
+// injectSelectionHighlight highlights the plain-text byte range [start, end) of
+// coloredLine with a blue background, like injectBgHighlights does for search
+// matches. charMap must be buildCharMap(coloredLine).
+func injectSelectionHighlight(coloredLine string, charMap []int, start, end int) string {
+	if start >= len(charMap)-1 || start >= end {
+		return coloredLine
+	}
+	if end >= len(charMap) {
+		end = len(charMap) - 1
+	}
+	byteStart, byteEnd := charMap[start], charMap[end]
+	return coloredLine[:byteStart] + "\x1b[44m" + coloredLine[byteStart:byteEnd] + "\x1b[49m" + coloredLine[byteEnd:]
+}
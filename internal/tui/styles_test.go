@@ -0,0 +1,277 @@
+package tui
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	sigyaml "sigs.k8s.io/yaml"
+)
+
+func init() {
+	// Tests run without a terminal; force colors so styles actually render.
+	lipgloss.SetColorProfile(termenv.TrueColor)
+}
+
+func TestColorizeJSON(t *testing.T) {
+	condition := map[string]interface{}{
+		"type":    "Available",
+		"status":  "False",
+		"message": "Job \"db-migrate\" failed: {\"reason\": \"BackoffLimitExceeded\"}\nretried 6 times\tgiving up",
+	}
+	marshalled, err := json.MarshalIndent(condition, "", "  ")
+	if err != nil {
+		t.Fatalf("failed to marshal condition: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		src     string
+		strings []string // tokens expected to be colored as strings
+		keys    []string // tokens expected to be colored as keys
+	}{
+		{
+			name: "condition message with escaped quotes and newlines",
+			src:  string(marshalled),
+			strings: []string{
+				`"Job \"db-migrate\" failed: {\"reason\": \"BackoffLimitExceeded\"}\nretried 6 times\tgiving up"`,
+				`"False"`,
+			},
+			keys: []string{`"message"`, `"status"`, `"type"`},
+		},
+		{
+			name:    "array element that looks like a key",
+			src:     "[\n  \"reason: \\\"x\\\": y\",\n  \"z\"\n]",
+			strings: []string{`"reason: \"x\": y"`, `"z"`},
+		},
+		{
+			name:    "string value spanning raw lines",
+			src:     "{\n  \"message\": \"first line\n  second: line\",\n  \"next\": 1\n}",
+			strings: []string{`"first line`, `  second: line"`},
+			keys:    []string{`"message"`, `"next"`},
+		},
+		{
+			name:    "key with escaped quote",
+			src:     "{\n  \"a\\\"b\": \"c\"\n}",
+			strings: []string{`"c"`},
+			keys:    []string{`"a\"b"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := colorizeJSON(tt.src)
+
+			// Search offsets are computed on the stripped text, so it must be unchanged.
+			if plain := stripANSI(got); plain != tt.src {
+				t.Errorf("stripped output differs from input:\n got: %q\nwant: %q", plain, tt.src)
+			}
+			for _, s := range tt.strings {
+				if !strings.Contains(got, styleJSONString.Render(s)) {
+					t.Errorf("expected %s to be colored as a string in %q", s, got)
+				}
+			}
+			for _, k := range tt.keys {
+				if !strings.Contains(got, styleJSONKey.Render(k)) {
+					t.Errorf("expected %s to be colored as a key in %q", k, got)
+				}
+			}
+		})
+	}
+}
+
+func TestOpensJSONString(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected bool
+	}{
+		{line: `"key": "value",`, expected: false},
+		{line: `"key": "unterminated`, expected: true},
+		{line: `"key": "escaped \" quote`, expected: true},
+		{line: `"key": "ends with backslash \\",`, expected: false},
+		{line: `  {`, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			if got := opensJSONString(tt.line); got != tt.expected {
+				t.Errorf("opensJSONString(%q) = %v, want %v", tt.line, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestColorizeYAMLLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		key     string // expected key token ("" for none)
+		value   string // expected value token
+		valueAs lipgloss.Style
+	}{
+		{
+			name:    "url value",
+			line:    "  endpoint: https://maestro.example.com:8443/api",
+			key:     "endpoint",
+			value:   "https://maestro.example.com:8443/api",
+			valueAs: styleJSONString,
+		},
+		{
+			name:    "timestamp value",
+			line:    "lastTransitionTime: 2024-01-02T15:04:05Z",
+			key:     "lastTransitionTime",
+			value:   "2024-01-02T15:04:05Z",
+			valueAs: styleJSONString,
+		},
+		{
+			name:    "quoted timestamp value",
+			line:    "- createdAt: '2024-01-02T15:04:05Z'",
+			key:     "createdAt",
+			value:   "'2024-01-02T15:04:05Z'",
+			valueAs: styleJSONString,
+		},
+		{
+			name:    "list item url",
+			line:    "- https://example.com/path",
+			value:   "https://example.com/path",
+			valueAs: styleJSONString,
+		},
+		{
+			name:    "quoted key containing a colon",
+			line:    `"app.io/owner: team": platform`,
+			key:     `"app.io/owner: team"`,
+			value:   "platform",
+			valueAs: styleJSONString,
+		},
+		{
+			name:    "single-quoted key with escaped quote",
+			line:    `'it''s: here': 3`,
+			key:     `'it''s: here'`,
+			value:   "3",
+			valueAs: styleJSONNumber,
+		},
+		{
+			name:    "quoted list item that looks like a mapping",
+			line:    `- "reason: failed"`,
+			value:   `"reason: failed"`,
+			valueAs: styleJSONString,
+		},
+		{
+			name:    "number",
+			line:    "replicas: 3",
+			key:     "replicas",
+			value:   "3",
+			valueAs: styleJSONNumber,
+		},
+		{
+			name:    "nested mapping key",
+			line:    "  metadata:",
+			key:     "metadata",
+			valueAs: styleJSONString,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := colorizeYAMLLine(tt.line)
+
+			if plain := stripANSI(got); plain != tt.line {
+				t.Errorf("stripped output differs from input:\n got: %q\nwant: %q", plain, tt.line)
+			}
+			if tt.key != "" && !strings.Contains(got, styleJSONKey.Render(tt.key)) {
+				t.Errorf("expected key %s in %q", tt.key, got)
+			}
+			if tt.key == "" && strings.Contains(got, styleJSONPunct.Render(": ")) {
+				t.Errorf("expected no key in %q", got)
+			}
+			if tt.value != "" && !strings.Contains(got, tt.valueAs.Render(tt.value)) {
+				t.Errorf("expected value %s rendered as a single token in %q", tt.value, got)
+			}
+		})
+	}
+}
+
+func TestColorizeYAMLBlockScalars(t *testing.T) {
+	condition := map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"type":    "Available",
+				"status":  "False",
+				"message": "Job db-migrate failed:\n  reason: BackoffLimitExceeded\n- attempts: 6\n\nsee https://example.com/runbook",
+			},
+		},
+		"name": "db-migrate",
+	}
+	marshalled, err := sigyaml.Marshal(condition)
+	if err != nil {
+		t.Fatalf("failed to marshal condition: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		src     string
+		content []string // block content lines, expected as whole string tokens
+		keys    []string // keys expected after the block ends
+	}{
+		{
+			name: "literal block in a condition message",
+			src:  string(marshalled),
+			content: []string{
+				"Job db-migrate failed:",
+				"reason: BackoffLimitExceeded",
+				"- attempts: 6",
+				"see https://example.com/runbook",
+			},
+			keys: []string{"status", "type", "name"},
+		},
+		{
+			name:    "folded block with chomping indicator",
+			src:     "spec:\n  description: >-\n    first: part\n    second part\n  replicas: 2",
+			content: []string{"first: part", "second part"},
+			keys:    []string{"replicas"},
+		},
+		{
+			name:    "block scalar list item",
+			src:     "items:\n- |\n  a: b\n- plain",
+			content: []string{"a: b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := colorizeYAML(tt.src)
+
+			if plain := stripANSI(got); plain != tt.src {
+				t.Errorf("stripped output differs from input:\n got: %q\nwant: %q", plain, tt.src)
+			}
+			for _, c := range tt.content {
+				if !strings.Contains(got, styleJSONString.Render(c)) {
+					t.Errorf("expected block content %q to be colored as a string in %q", c, got)
+				}
+				if key, _, ok := strings.Cut(strings.TrimPrefix(c, "- "), ": "); ok {
+					if strings.Contains(got, styleJSONKey.Render(key)) {
+						t.Errorf("block content %q was colored as a mapping key", c)
+					}
+				}
+			}
+			for _, k := range tt.keys {
+				if !strings.Contains(got, styleJSONKey.Render(k)) {
+					t.Errorf("expected key %s after the block in %q", k, got)
+				}
+			}
+		})
+	}
+}
+
+func TestIsTheme(t *testing.T) {
+	for _, name := range ThemeNames() {
+		if !IsTheme(name) || !IsTheme(strings.ToUpper(name)) {
+			t.Errorf("expected %q to be a theme in any case", name)
+		}
+	}
+	if IsTheme("solarized-neon") {
+		t.Error("expected an unknown name not to be a theme")
+	}
+}
@@ -20,12 +20,13 @@ type Logger struct {
 
 // Config holds logging configuration following HyperFleet standards
 type Config struct {
-	Level     string // debug, info, warn, error
-	Format    string // text, json
-	Output    string // stdout, stderr
-	Component string // component name
-	Version   string // component version
-	Hostname  string // pod name or hostname
+	Level     string    // debug, info, warn, error
+	Format    string    // text, json
+	Output    string    // stdout, stderr
+	Writer    io.Writer // overrides Output when set, e.g. an open log file
+	Component string    // component name
+	Version   string    // component version
+	Hostname  string    // pod name or hostname
 }
 
 // Fields represents structured log fields
@@ -96,8 +97,10 @@ func New(config Config) *Logger {
 
 	// Set output destination
 	var output io.Writer
-	switch config.Output {
-	case "stderr":
+	switch {
+	case config.Writer != nil:
+		output = config.Writer
+	case config.Output == "stderr":
 		output = os.Stderr
 	default:
 		output = os.Stdout